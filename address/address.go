@@ -2,6 +2,7 @@ package address
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -18,22 +19,38 @@ var (
 	ErrInvalidHexAddress  = errors.New("invalid hex address")
 	ErrInvalidBech32      = errors.New("invalid bech32 address")
 	ErrInvalidAddressType = errors.New("invalid address type")
+
+	ErrInvalidBech32Prefix  = errors.New("invalid bech32 address prefix")
+	ErrInvalidBech32Variant = errors.New("rooch bech32 address must be bech32m encoded")
+)
+
+// AddressFormat selects how a [RoochAddress] is displayed by [RoochAddress.StringFormat]
+type AddressFormat int
+
+const (
+	// AddressFormatCanonical is 0x1 for special addresses and the long hex otherwise, see [RoochAddress.String]
+	AddressFormatCanonical AddressFormat = iota
+	// AddressFormatLong is the 0x prefixed 64 characters hex
+	AddressFormatLong
+	// AddressFormatShort is the hex without leading zeros
+	AddressFormatShort
+	// AddressFormatBech32 is the rooch1... bech32m form
+	AddressFormatBech32
 )
 
-// RoochAddress a 32-byte representation of an on-chain address
+// RoochAddress a 32-byte representation of an on-chain address, it is the Move address
+// type and is encoded as 32 fixed bytes in BCS
 //
 // Implements:
 //   - [bcs.Marshaler]
 //   - [bcs.Unmarshaler]
 //   - [json.Marshaler]
 //   - [json.Unmarshaler]
-//
-// type RoochAddress H256
-// type RoochAddress [32]byte
-// RoochAddress implements the Address interface
-type RoochAddress struct {
-	address [32]byte
-}
+//   - [encoding.TextMarshaler]
+//   - [encoding.TextUnmarshaler]
+//   - [sql.Scanner]
+//   - [driver.Valuer]
+type RoochAddress [32]byte
 
 const RoochBech32Prefix = "rooch"
 
@@ -43,19 +60,19 @@ const RoochAddressLength = 32
 //const GAS_TOKEN_CODE = "0x3::gas_coin::RGas"
 
 // AddressZero is [RoochAddress] 0x0
-var AddressZero = RoochAddress{[32]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}}
+var AddressZero = RoochAddress{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 
 // AddressOne is [RoochAddress] 0x1
-var AddressOne = RoochAddress{[32]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}}
+var AddressOne = RoochAddress{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
 
 // AddressTwo is [RoochAddress] 0x2
-var AddressTwo = RoochAddress{[32]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}}
+var AddressTwo = RoochAddress{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}
 
 // AddressThree is [RoochAddress] 0x3
-var AddressThree = RoochAddress{[32]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3}}
+var AddressThree = RoochAddress{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3}
 
 // AddressFour is [RoochAddress] 0x4
-var AddressFour = RoochAddress{[32]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4}}
+var AddressFour = RoochAddress{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4}
 
 // IsSpecial Returns whether the address is a "special" address. Addresses are considered
 // special if the first 63 characters of the hex string are zero. In other words,
@@ -65,12 +82,12 @@ var AddressFour = RoochAddress{[32]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0
 // the addresses in the range from `0x0` to `0xf` (inclusive) are special.
 // For more details see the v1 address standard defined as part of AIP-40:
 func (ra *RoochAddress) IsSpecial() bool {
-	for _, b := range ra.address[:31] {
+	for _, b := range ra[:31] {
 		if b != 0 {
 			return false
 		}
 	}
-	return ra.address[31] < 0x10
+	return ra[31] < 0x10
 }
 
 // String Returns the canonical string representation of the [RoochAddress]
@@ -78,22 +95,22 @@ func (ra *RoochAddress) IsSpecial() bool {
 // Please use [RoochAddress.StringLong] for all indexer queries.
 func (ra *RoochAddress) String() string {
 	if ra.IsSpecial() {
-		return fmt.Sprintf("0x%x", ra.address[31])
+		return fmt.Sprintf("0x%x", ra[31])
 	} else {
 		//	return "0x" + hex.EncodeToString(a.Bytes())
-		return toHex(ra.address[:])
+		return toHex(ra[:])
 	}
 }
 
 //// FromAuthKey converts [crypto.AuthenticationKey] to [RoochAddress]
 //func (ra *RoochAddress) FromAuthKey(authKey *crypto.AuthenticationKey) {
-//	copy(ra.address[:], authKey[:])
+//	copy(ra[:], authKey[:])
 //}
 //
 //// AuthKey converts [RoochAddress] to [crypto.AuthenticationKey]
 //func (ra *RoochAddress) AuthKey() *crypto.AuthenticationKey {
 //	authKey := &crypto.AuthenticationKey{}
-//	copy(authKey[:], ra.address[:])
+//	copy(authKey[:], ra[:])
 //	return authKey
 //}
 
@@ -101,40 +118,131 @@ func (ra *RoochAddress) String() string {
 //
 // This is most commonly used for all indexer queries.
 func (ra *RoochAddress) StringLong() string {
-	return utils.BytesToHex(ra.address[:])
+	return utils.BytesToHex(ra[:])
+}
+
+// StringShort Returns the hex representation of the RoochAddress without leading zeros, e.g. 0x3
+func (ra *RoochAddress) StringShort() string {
+	short := strings.TrimLeft(hex.EncodeToString(ra[:]), "0")
+	if short == "" {
+		short = "0"
+	}
+	return "0x" + short
+}
+
+// StringFormat Returns the string representation of the RoochAddress in the given format
+func (ra *RoochAddress) StringFormat(format AddressFormat) string {
+	switch format {
+	case AddressFormatLong:
+		return ra.StringLong()
+	case AddressFormatShort:
+		return ra.StringShort()
+	case AddressFormatBech32:
+		// Encoding 32 bytes can not fail
+		bech32Addr, _ := ra.ToBech32()
+		return bech32Addr
+	default:
+		return ra.String()
+	}
 }
 
 // MarshalBCS Converts the RoochAddress to BCS encoded bytes
 func (ra *RoochAddress) MarshalBCS(ser *bcs.Serializer) {
-	//ser.FixedBytes(ra.address[:])
-	ser.WriteBytes(ra.address[:])
+	ser.FixedBytes(ra[:])
 }
 
 // UnmarshalBCS Converts the RoochAddress from BCS encoded bytes
 func (ra *RoochAddress) UnmarshalBCS(des *bcs.Deserializer) {
-	//des.ReadFixedBytesInto((*ra)[:])
-	//des.ReadFixedBytesInto((*ra)[:])
-	des.ReadBytes()
+	des.ReadFixedBytesInto(ra[:])
 }
 
-// MarshalJSON converts the RoochAddress to JSON
-func (ra *RoochAddress) MarshalJSON() ([]byte, error) {
+// MarshalJSON converts the RoochAddress to JSON in its canonical string form, see [RoochAddress.String]
+func (ra RoochAddress) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ra.String())
 }
 
-//// UnmarshalJSON converts the RoochAddress from JSON
-//func (ra *RoochAddress) UnmarshalJSON(b []byte) error {
-//	var str string
-//	err := json.Unmarshal(b, &str)
-//	if err != nil {
-//		return fmt.Errorf("failed to convert input to AccountAdddress: %w", err)
-//	}
-//	err = ra.ParseStringRelaxed(str)
-//	if err != nil {
-//		return fmt.Errorf("failed to convert input to AccountAdddress: %w", err)
-//	}
-//	return nil
-//}
+// UnmarshalJSON converts the RoochAddress from JSON
+func (ra *RoochAddress) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err != nil {
+		return fmt.Errorf("failed to convert input to RoochAddress: %w", err)
+	}
+	return ra.UnmarshalText([]byte(str))
+}
+
+// MarshalText converts the RoochAddress to its canonical string form, see [RoochAddress.String]
+func (ra RoochAddress) MarshalText() ([]byte, error) {
+	return []byte(ra.String()), nil
+}
+
+// UnmarshalText converts the RoochAddress from any format [ParseRoochAddress] accepts
+func (ra *RoochAddress) UnmarshalText(text []byte) error {
+	parsed, err := ParseRoochAddress(string(text))
+	if err != nil {
+		return fmt.Errorf("failed to convert input to RoochAddress: %w", err)
+	}
+	*ra = *parsed
+	return nil
+}
+
+// Scan converts the RoochAddress from a database column holding either the 32 raw bytes or the string form.
+// Drivers may return a text column as []byte, so bytes with the 0x or rooch1 prefix of the string forms are
+// parsed as text first, and 32 bytes are only taken as the raw address otherwise
+func (ra *RoochAddress) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		if hasTextPrefix(v) {
+			err := ra.UnmarshalText(v)
+			if err == nil || len(v) != RoochAddressLength {
+				return err
+			}
+		}
+		if len(v) == RoochAddressLength {
+			copy(ra[:], v)
+			return nil
+		}
+		return ra.UnmarshalText(v)
+	case string:
+		return ra.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidAddressType, src)
+	}
+}
+
+// hasTextPrefix reports whether the column value starts like the hex or bech32 string form of an address
+func hasTextPrefix(v []byte) bool {
+	return bytes.HasPrefix(v, []byte("0x")) || bytes.HasPrefix(v, []byte(RoochBech32Prefix+"1"))
+}
+
+// Value converts the RoochAddress to its long string form for a database column
+func (ra RoochAddress) Value() (driver.Value, error) {
+	return ra.StringLong(), nil
+}
+
+// ParseStringRelaxed parses a hex string into the RoochAddress, short forms such as 0x1 are left padded
+// TODO: add strict mode checking
+func (ra *RoochAddress) ParseStringRelaxed(x string) error {
+	x = strings.TrimPrefix(x, "0x")
+	if len(x) < 1 {
+		return ErrAddressTooShort
+	}
+	if len(x) > 64 {
+		return ErrAddressTooLong
+	}
+	if len(x)%2 != 0 {
+		x = "0" + x
+	}
+	bytes, err := hex.DecodeString(x)
+	if err != nil {
+		return err
+	}
+	// zero-prefix/right-align what bytes we got
+	*ra = RoochAddress{}
+	copy(ra[RoochAddressLength-len(bytes):], bytes)
+
+	return nil
+}
 
 //// NamedObjectAddress derives a named object address based on the input address as the creator
 //func (ra *RoochAddress) NamedObjectAddress(seed []byte) (accountAddress RoochAddress) {
@@ -188,33 +296,16 @@ func NewRoochAddressFromBytes(bytes []byte) (*RoochAddress, error) {
 	if len(bytes) != RoochAddressLength {
 		return nil, ErrInvalidAddressLen
 	}
-	ra := RoochAddress{address: [32]byte(bytes)}
+	ra := RoochAddress(bytes)
 	return &ra, nil
 }
 
-// NewRoochAddress creates a new RoochAddress from either a byte slice or string
+// NewRoochAddress creates a new RoochAddress from either a byte slice or string, see [ParseRoochAddress]
+// for the accepted strings
 func NewRoochAddress(addr interface{}) (*RoochAddress, error) {
 	switch v := addr.(type) {
 	case string:
-		if isHex(v) {
-			// Handle hex string
-			v = NormalizeRoochAddress(v, true)
-			bytes, err := fromHex(v)
-			if err != nil {
-				return nil, err
-			}
-			return NewRoochAddressFromBytes(bytes)
-		}
-		// Handle bech32 string
-		_, data, err := bech32.Decode(v)
-		if err != nil {
-			return nil, err
-		}
-		converted, err := bech32.ConvertBits(data, 5, 8, false)
-		if err != nil {
-			return nil, err
-		}
-		return NewRoochAddressFromBytes(converted)
+		return ParseRoochAddress(v)
 	case []byte:
 		return NewRoochAddressFromBytes(v)
 	default:
@@ -263,22 +354,78 @@ func NewRoochAddress(addr interface{}) (*RoochAddress, error) {
 // Bytes returns the raw bytes of the address
 func (ra *RoochAddress) Bytes() []byte {
 	//return ([]byte)(a)
-	return ra.address[:]
+	return ra[:]
+}
+
+// ToMultiChainAddress wraps the address with the Rooch chain ID
+func (ra *RoochAddress) ToMultiChainAddress() *MultiChainAddress {
+	return &MultiChainAddress{
+		MultiChainID: MultiChainIDRooch,
+		RawAddress:   ra.Bytes(),
+	}
 }
 
-// ToBech32 converts the address to bech32 format
+// ToBech32 converts the address to the rooch1... bech32m format the Rooch CLI prints
 func (ra *RoochAddress) ToBech32() (string, error) {
 	converted, err := bech32.ConvertBits(ra.Bytes(), 8, 5, true)
 	if err != nil {
 		return "", err
 	}
-	encoded, err := bech32.Encode(RoochBech32Prefix, converted)
+	encoded, err := bech32.EncodeM(RoochBech32Prefix, converted)
 	if err != nil {
 		return "", err
 	}
 	return encoded, nil
 }
 
+// ParseRoochAddress parses every address format the Rooch CLI prints: the long hex, the short hex
+// such as 0x3, with or without the 0x prefix, and the rooch1... bech32m form
+func ParseRoochAddress(input string) (*RoochAddress, error) {
+	if isRoochBech32(input) {
+		return decodeRoochBech32(input)
+	}
+	normalized, err := NormalizeRoochAddressStrict(input, false)
+	if err != nil {
+		return nil, err
+	}
+	bytes, err := fromHex(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidHexAddress, input)
+	}
+	return NewRoochAddressFromBytes(bytes)
+}
+
+// isRoochBech32 checks if the input looks like a bech32 string rather than hex, the HRP is checked on decode
+func isRoochBech32(input string) bool {
+	if strings.LastIndexByte(input, '1') <= 0 {
+		return false
+	}
+	for _, c := range strings.TrimPrefix(strings.ToLower(input), "0x") {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeRoochBech32(input string) (*RoochAddress, error) {
+	hrp, data, version, err := bech32.DecodeGeneric(input)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBech32, err)
+	}
+	if hrp != RoochBech32Prefix {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrInvalidBech32Prefix, RoochBech32Prefix, hrp)
+	}
+	if version != bech32.VersionM {
+		return nil, ErrInvalidBech32Variant
+	}
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBech32, err)
+	}
+	return NewRoochAddressFromBytes(converted)
+}
+
 //// NewBitcoinAddress creates a new BitcoinAddress
 //func NewBitcoinAddress(address string) (*BitcoinAddress, error) {
 //	// Here you would implement Bitcoin address validation
@@ -331,20 +478,55 @@ func (ra *RoochAddress) ToBech32() (string, error) {
 //	return "0x" + address
 //}
 
-// NormalizeRoochAddress normalizes a Rooch address
+// NormalizeRoochAddress normalizes a Rooch address to its long hex form, a bech32 address is converted.
+// An invalid address is only lower cased and left padded, use [NormalizeRoochAddressStrict] to reject it
 func NormalizeRoochAddress(input string, forceAdd0x bool) string {
-	addr := strings.ToLower(input)
-	if strings.HasPrefix(addr, "0x") {
-		addr = addr[2:]
+	if normalized, err := NormalizeRoochAddressStrict(input, forceAdd0x); err == nil {
+		return normalized
 	}
+	addr := strings.TrimPrefix(strings.ToLower(input), "0x")
 	targetLen := RoochAddressLength * 2
-	addr = strings.Repeat("0", targetLen-len(addr)) + addr
+	if len(addr) < targetLen {
+		addr = strings.Repeat("0", targetLen-len(addr)) + addr
+	}
 	if forceAdd0x {
 		addr = "0x" + addr
 	}
 	return addr
 }
 
+// NormalizeRoochAddressStrict normalizes a Rooch address to its long hex form, a bech32 address is converted.
+// It fails on an invalid bech32 address and on a hex address which is not hex or longer than 32 bytes
+func NormalizeRoochAddressStrict(input string, forceAdd0x bool) (string, error) {
+	if isRoochBech32(input) {
+		ra, err := decodeRoochBech32(input)
+		if err != nil {
+			return "", err
+		}
+		input = ra.StringLong()
+	}
+	addr := strings.TrimPrefix(strings.ToLower(input), "0x")
+	targetLen := RoochAddressLength * 2
+	if len(addr) == 0 || len(addr) > targetLen || !isHexDigits(addr) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidHexAddress, input)
+	}
+	addr = strings.Repeat("0", targetLen-len(addr)) + addr
+	if forceAdd0x {
+		addr = "0x" + addr
+	}
+	return addr, nil
+}
+
+// isHexDigits checks that every character is a lower case hex digit, the length may be odd
+func isHexDigits(s string) bool {
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
 // ToCanonicalRoochAddress returns the canonical form of a Rooch address
 func ToCanonicalRoochAddress(input string, forceAdd0x bool) string {
 	return NormalizeRoochAddress(input, forceAdd0x)
@@ -383,8 +565,3 @@ func toHex(b []byte) string {
 func BytesEqual(a, b []byte) bool {
 	return bytes.Equal(a, b)
 }
-
-type MultiChainAddress struct {
-	MultiChainID int
-	RawAddress   []byte
-}
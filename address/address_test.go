@@ -1,9 +1,11 @@
 package address
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
+	"github.com/rooch-network/rooch-go-sdk/bcs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,3 +36,144 @@ func TestRoochAddress(t *testing.T) {
 		assert.Nil(t, address)
 	})
 }
+
+func TestRoochAddressEncoding(t *testing.T) {
+	t.Run("Fixed length BCS", func(t *testing.T) {
+		data, err := bcs.Serialize(&AddressThree)
+		assert.NoError(t, err)
+		assert.Equal(t, RoochAddressLength, len(data))
+
+		var decoded RoochAddress
+		assert.NoError(t, bcs.Deserialize(&decoded, data))
+		assert.Equal(t, AddressThree, decoded)
+
+		assert.Error(t, bcs.Deserialize(&decoded, data[:31]))
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		var decoded RoochAddress
+		assert.NoError(t, decoded.UnmarshalText([]byte("0x3")))
+		assert.Equal(t, AddressThree, decoded)
+
+		text, err := AddressThree.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "0x3", string(text))
+
+		addr, _ := NewRoochAddress("0x0000123412341234123412341234123412341234123412340123456789abcdef")
+		bech32Addr, _ := addr.ToBech32()
+		assert.NoError(t, decoded.UnmarshalText([]byte(bech32Addr)))
+		assert.Equal(t, *addr, decoded)
+		assert.Equal(t, "0x123412341234123412341234123412341234123412340123456789abcdef", addr.StringShort())
+	})
+
+	t.Run("JSON in struct values", func(t *testing.T) {
+		type testStruct struct {
+			Address RoochAddress `json:"address"`
+		}
+		data, err := json.Marshal(testStruct{Address: AddressTwo})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"address":"0x2"}`, string(data))
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		value, err := AddressOne.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, AddressOne.StringLong(), value)
+
+		var scanned RoochAddress
+		assert.NoError(t, scanned.Scan(value))
+		assert.Equal(t, AddressOne, scanned)
+		assert.NoError(t, scanned.Scan(AddressTwo.Bytes()))
+		assert.Equal(t, AddressTwo, scanned)
+		assert.Error(t, scanned.Scan(1))
+
+		// A short hex string returned as []byte by the driver is 32 bytes long like a raw address
+		short := []byte("0x" + strings.Repeat("0", 29) + "3")
+		assert.Len(t, short, RoochAddressLength)
+		assert.NoError(t, scanned.Scan(short))
+		assert.Equal(t, AddressThree, scanned)
+		// Raw bytes which only look like the prefix are still the raw address
+		raw := RoochAddress{'0', 'x', 0xff}
+		assert.NoError(t, scanned.Scan(raw.Bytes()))
+		assert.Equal(t, raw, scanned)
+	})
+}
+
+func TestRoochAddressBech32(t *testing.T) {
+	hexAddr := "0xf892b3fd5fd0e93436ba3dc8d504413769d66901266143d00e49441079243ed0"
+	bech32Addr := "rooch1lzft8l2l6r5ngd468hyd2pzpxa5av6gpyes585qwf9zpq7fy8mgqh9npj5"
+
+	t.Run("Same as rooch cli", func(t *testing.T) {
+		addr, err := NewRoochAddress(hexAddr)
+		assert.NoError(t, err)
+		encoded, err := addr.ToBech32()
+		assert.NoError(t, err)
+		assert.Equal(t, bech32Addr, encoded)
+
+		parsed, err := ParseRoochAddress(bech32Addr)
+		assert.NoError(t, err)
+		assert.Equal(t, *addr, *parsed)
+		normalized, err := NormalizeRoochAddressStrict(bech32Addr, true)
+		assert.NoError(t, err)
+		assert.Equal(t, hexAddr, normalized)
+		assert.Equal(t, hexAddr, NormalizeRoochAddress(bech32Addr, true))
+	})
+
+	t.Run("Strict prefix and variant", func(t *testing.T) {
+		_, err := ParseRoochAddress("bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu")
+		assert.ErrorIs(t, err, ErrInvalidBech32Prefix)
+
+		// Same payload with the bech32 rather than the bech32m checksum
+		_, err = ParseRoochAddress("rooch1lzft8l2l6r5ngd468hyd2pzpxa5av6gpyes585qwf9zpq7fy8mgqzerdhk")
+		assert.ErrorIs(t, err, ErrInvalidBech32Variant)
+
+		_, err = ParseRoochAddress("rooch1lzft8l2l6r5ngd468hyd2pzpxa5av6gpyes585qwf9zpq7fy8mgqh9npj4")
+		assert.ErrorIs(t, err, ErrInvalidBech32)
+	})
+
+	t.Run("Every cli format", func(t *testing.T) {
+		for _, input := range []string{"0x3", "3", "0x03", AddressThree.StringLong(), AddressThree.StringFormat(AddressFormatBech32)} {
+			addr, err := ParseRoochAddress(input)
+			assert.NoError(t, err, input)
+			assert.Equal(t, AddressThree, *addr)
+		}
+		_, err := ParseRoochAddress("0x")
+		assert.ErrorIs(t, err, ErrInvalidHexAddress)
+	})
+
+	t.Run("Normalize invalid addresses", func(t *testing.T) {
+		// A bech32 rather than bech32m address is not turned into 0xrooch1...
+		_, err := NormalizeRoochAddressStrict("rooch1lzft8l2l6r5ngd468hyd2pzpxa5av6gpyes585qwf9zpq7fy8mgqzerdhk", true)
+		assert.ErrorIs(t, err, ErrInvalidBech32Variant)
+
+		_, err = NormalizeRoochAddressStrict("0x"+strings.Repeat("1", RoochAddressLength*2+1), true)
+		assert.ErrorIs(t, err, ErrInvalidHexAddress)
+		_, err = NormalizeRoochAddressStrict("0x2345z", true)
+		assert.ErrorIs(t, err, ErrInvalidHexAddress)
+
+		// The lenient form only lower cases and pads, as it always did
+		assert.Equal(t, "0x"+strings.Repeat("0", RoochAddressLength*2-5)+"2345z", NormalizeRoochAddress("0x2345Z", true))
+		assert.Equal(t, strings.Repeat("0", RoochAddressLength*2-1)+"3", ToCanonicalRoochAddress("0x3", false))
+	})
+
+	t.Run("String formats", func(t *testing.T) {
+		addr, _ := NewRoochAddress(hexAddr)
+		assert.Equal(t, bech32Addr, addr.StringFormat(AddressFormatBech32))
+		assert.Equal(t, hexAddr, addr.StringFormat(AddressFormatCanonical))
+		assert.Equal(t, AddressThree.StringLong(), AddressThree.StringFormat(AddressFormatLong))
+		assert.Equal(t, "0x3", AddressThree.StringFormat(AddressFormatShort))
+		assert.Equal(t, "0x3", AddressThree.StringFormat(AddressFormatCanonical))
+
+		// JSON and text are always the canonical hex, bech32 is accepted when decoding
+		data, err := json.Marshal(addr)
+		assert.NoError(t, err)
+		assert.Equal(t, `"`+hexAddr+`"`, string(data))
+		text, err := AddressThree.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "0x3", string(text))
+
+		var decoded RoochAddress
+		assert.NoError(t, json.Unmarshal([]byte(`"`+bech32Addr+`"`), &decoded))
+		assert.Equal(t, *addr, decoded)
+	})
+}
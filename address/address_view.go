@@ -3,6 +3,8 @@
 
 package address
 
+import "github.com/btcsuite/btcd/btcec/v2/schnorr"
+
 // AddressView implements the Address interface
 type AddressView struct {
 	BitcoinAddress BitcoinAddress
@@ -10,18 +12,24 @@ type AddressView struct {
 	RoochAddress   RoochAddress
 }
 
-// NewAddressView creates a new AddressView instance
+// NewAddressView creates a new AddressView instance for Bitcoin regtest, use
+// [NewAddressViewWithNetwork] for the addresses on another network
 func NewAddressView(publicKey []byte) (*AddressView, error) {
 	return NewAddressViewWithNetwork(publicKey, BitcoinNetworkRegtest)
 }
 
-// NewAddressView creates a new AddressView instance
+// NewAddressViewWithNetwork creates a new AddressView instance from a compressed or x-only
+// public key, the Bitcoin address is the Taproot address on the given network
 func NewAddressViewWithNetwork(publicKey []byte, network BitcoinNetworkType) (*AddressView, error) {
 	bitcoinAddr, err := BitcoinAddressFromPublicKey(publicKey, network)
 	if err != nil {
 		return nil, err
 	}
-	nostrAddr, err := NewNostrAddress(publicKey)
+	xOnly := publicKey
+	if len(publicKey) == schnorr.PubKeyBytesLen+1 {
+		xOnly = publicKey[1:]
+	}
+	nostrAddr, err := NewNostrAddress(xOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +45,11 @@ func NewAddressViewWithNetwork(publicKey []byte, network BitcoinNetworkType) (*A
 	}, nil
 }
 
+// Network returns the network of the Bitcoin address
+func (av *AddressView) Network() BitcoinNetworkType {
+	return av.BitcoinAddress.Network()
+}
+
 // ToBytes returns the byte representation of the address
 func (av *AddressView) ToBytes() []byte {
 	return av.RoochAddress.Bytes()
@@ -4,8 +4,11 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/base58"
 	"github.com/btcsuite/btcd/btcutil/bech32"
 	"golang.org/x/crypto/blake2b"
@@ -30,6 +33,16 @@ const (
 	WITNESS BitcoinAddressType = 2
 )
 
+// BitcoinPaymentType represents the standard scripts a single public key can be paid to
+type BitcoinPaymentType int
+
+const (
+	P2PKH BitcoinPaymentType = iota
+	P2SHP2WPKH
+	P2WPKH
+	P2TR
+)
+
 const (
 	PubkeyAddressPrefixMain = 0x00
 	PubkeyAddressPrefixTest = 0x6F
@@ -95,12 +108,14 @@ type BitcoinAddressInfo struct {
 type BitcoinAddress struct {
 	bytes        []byte
 	rawAddress   string
+	network      BitcoinNetworkType
 	roochAddress *RoochAddress
 }
 
-// NewBitcoinAddress creates a new BitcoinAddress instance
+// NewBitcoinAddress creates a new BitcoinAddress instance, a hex input is the wrapped
+// address bytes and is encoded for the given network
 func NewBitcoinAddress(input string, network BitcoinNetworkType) (*BitcoinAddress, error) {
-	ba := &BitcoinAddress{rawAddress: input}
+	ba := &BitcoinAddress{rawAddress: input, network: network}
 
 	if isHex(input) {
 		// Handle hex input
@@ -112,38 +127,50 @@ func NewBitcoinAddress(input string, network BitcoinNetworkType) (*BitcoinAddres
 		if err != nil {
 			return nil, err
 		}
+		if len(decoded) < 2 {
+			return nil, ErrInvalidAddressLen
+		}
 		ba.bytes = decoded
 
-		var prefixed []byte
-		version := ba.bytes[1]
-
 		switch BitcoinAddressType(ba.bytes[0]) {
 		case PKH:
-			prefixed = make([]byte, 22)
-			prefixed[0] = version
-			prefixed[1] = ba.GetPubkeyAddressPrefix(network)
-			copy(prefixed[2:], ba.bytes[2:])
-			ba.rawAddress = base58.CheckEncode(prefixed[1:], prefixed[0])
+			if len(ba.bytes) != 21 {
+				return nil, ErrInvalidAddressLen
+			}
+			ba.rawAddress = base58.CheckEncode(ba.bytes[1:], ba.GetPubkeyAddressPrefix(network))
 
 		case SH:
-			prefixed = make([]byte, 22)
-			prefixed[0] = version
-			prefixed[1] = ba.GetScriptAddressPrefix(network)
-			copy(prefixed[2:], ba.bytes[2:])
-			ba.rawAddress = base58.CheckEncode(prefixed[1:], prefixed[0])
+			if len(ba.bytes) != 21 {
+				return nil, ErrInvalidAddressLen
+			}
+			ba.rawAddress = base58.CheckEncode(ba.bytes[1:], ba.GetScriptAddressPrefix(network))
 
 		case WITNESS:
+			version := ba.bytes[1]
+			if err := validateWitness(version, ba.bytes[2:]); err != nil {
+				return nil, err
+			}
 			hrp := NewBitcoinNetwork(network).Bech32HRP()
 			program := ba.bytes[2:]
 			conv, err := bech32.ConvertBits(program, 8, 5, true)
 			if err != nil {
 				return nil, err
 			}
-			encoded, err := bech32.EncodeM(hrp, conv)
+			// The witness version is the first 5-bit word, v0 uses bech32 and v1+ bech32m
+			conv = append([]byte{version}, conv...)
+			var encoded string
+			if version == 0 {
+				encoded, err = bech32.Encode(hrp, conv)
+			} else {
+				encoded, err = bech32.EncodeM(hrp, conv)
+			}
 			if err != nil {
 				return nil, err
 			}
 			ba.rawAddress = encoded
+
+		default:
+			return nil, fmt.Errorf("%w: %d", ErrInvalidAddressType, ba.bytes[0])
 		}
 	} else {
 		// Handle non-hex input
@@ -152,6 +179,7 @@ func NewBitcoinAddress(input string, network BitcoinNetworkType) (*BitcoinAddres
 			return nil, err
 		}
 		ba.bytes = ba.WrapAddress(info.Type, info.Bytes, info.Version)
+		ba.network = resolveNetwork(input, network)
 	}
 
 	return ba, nil
@@ -161,35 +189,61 @@ func BitcoinAddressOnlyFromPublicKey(publicKey []byte) (*BitcoinAddress, error)
 	return BitcoinAddressFromPublicKey(publicKey, BitcoinNetworkSignet)
 }
 
-// FromPublicKey creates a BitcoinAddress from a public key
+// BitcoinAddressFromPublicKey creates the key path only Taproot address of a compressed
+// or x-only public key, the key is tweaked as BIP-86 describes
 func BitcoinAddressFromPublicKey(publicKey []byte, network BitcoinNetworkType) (*BitcoinAddress, error) {
-	// Implement taproot public key to address conversion
-	pubKey, err := schnorr.ParsePubKey(publicKey)
-	if err != nil {
-		return nil, err
-	}
+	return BitcoinAddressFromPublicKeyWithType(publicKey, P2TR, network)
+}
 
-	// Convert to taproot output key
-	taprootKey := schnorr.SerializePubKey(pubKey)
+// BitcoinAddressFromPublicKeyWithType creates the address of the given payment type from a
+// compressed public key, P2TR also accepts an x-only public key
+func BitcoinAddressFromPublicKeyWithType(publicKey []byte, paymentType BitcoinPaymentType, network BitcoinNetworkType) (*BitcoinAddress, error) {
+	if paymentType == P2TR {
+		internalKey, err := parseTaprootKey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		outputKey := txscript.ComputeTaprootKeyNoScript(internalKey)
+		return BitcoinTaprootAddressFromOutputKey(schnorr.SerializePubKey(outputKey), network)
+	}
 
-	// Convert to 5-bit Bech32m words
-	program, err := bech32.ConvertBits(taprootKey, 8, 5, true)
-	if err != nil {
+	if _, err := btcec.ParsePubKey(publicKey); err != nil {
 		return nil, err
 	}
+	if len(publicKey) != btcec.PubKeyBytesLenCompressed {
+		return nil, fmt.Errorf("%w: compressed public key expected", ErrInvalidAddressLen)
+	}
+	keyHash := btcutil.Hash160(publicKey)
+
+	var data []byte
+	switch paymentType {
+	case P2PKH:
+		data = wrapAddress(PKH, keyHash, 0)
+	case P2WPKH:
+		data = wrapAddress(WITNESS, keyHash, 0)
+	case P2SHP2WPKH:
+		// The redeem script is the v0 witness program of the key hash
+		redeemScript := append([]byte{txscript.OP_0, txscript.OP_DATA_20}, keyHash...)
+		data = wrapAddress(SH, btcutil.Hash160(redeemScript), 0)
+	default:
+		return nil, fmt.Errorf("unsupported payment type: %d", paymentType)
+	}
+	return NewBitcoinAddress(hex.EncodeToString(data), network)
+}
 
-	// Add witness version
-	version := []byte{0x01}
-	program = append(version, program...)
-
-	// Encode with Bech32m
-	hrp := NewBitcoinNetwork(network).Bech32HRP()
-	address, err := bech32.EncodeM(hrp, program)
-	if err != nil {
+// BitcoinTaprootAddressFromOutputKey creates the Taproot address of an already tweaked x-only output key
+func BitcoinTaprootAddressFromOutputKey(outputKey []byte, network BitcoinNetworkType) (*BitcoinAddress, error) {
+	if _, err := schnorr.ParsePubKey(outputKey); err != nil {
 		return nil, err
 	}
+	return NewBitcoinAddress(hex.EncodeToString(wrapAddress(WITNESS, outputKey, 1)), network)
+}
 
-	return NewBitcoinAddress(address, network)
+func parseTaprootKey(publicKey []byte) (*btcec.PublicKey, error) {
+	if len(publicKey) == schnorr.PubKeyBytesLen {
+		return schnorr.ParsePubKey(publicKey)
+	}
+	return btcec.ParsePubKey(publicKey)
 }
 
 // ToBytes returns the address bytes
@@ -197,15 +251,31 @@ func (ba *BitcoinAddress) ToBytes() []byte {
 	return []byte(ba.rawAddress)
 }
 
-//type MultiChainAddress struct {
-//	MultiChainID int
-//	RawAddress   []byte
-//}
+// String returns the address string
+func (ba *BitcoinAddress) String() string {
+	return ba.rawAddress
+}
+
+// Network returns the network the address was created for
+func (ba *BitcoinAddress) Network() BitcoinNetworkType {
+	return ba.network
+}
 
-// GenMultiChainAddress generates a multi-chain address
+// ToMultiChainAddress wraps the address with the Bitcoin chain ID
+func (ba *BitcoinAddress) ToMultiChainAddress() *MultiChainAddress {
+	return &MultiChainAddress{
+		MultiChainID: MultiChainIDBitcoin,
+		RawAddress:   ba.bytes,
+	}
+}
+
+// GenMultiChainAddress generates the BCS encoded multi-chain address
 func (ba *BitcoinAddress) GenMultiChainAddress() []byte {
-	// Implement BCS serialization here
-	return nil
+	bytes, err := ba.ToMultiChainAddress().ToBytes()
+	if err != nil {
+		return nil
+	}
+	return bytes
 }
 
 // GenRoochAddress generates a Rooch address
@@ -262,30 +332,36 @@ func (ba *BitcoinAddress) Decode() (*BitcoinAddressInfo, error) {
 	}
 
 	// Try base58check
-	decoded58 := base58.Decode(ba.rawAddress)
-	if len(decoded58) != 21 {
+	decoded58, prefix, err := base58.CheckDecode(ba.rawAddress)
+	if err != nil || len(decoded58) != 20 {
 		return nil, errors.New("invalid base58 address")
 	}
 
-	switch decoded58[0] {
-	case PubkeyAddressPrefixMain:
+	switch prefix {
+	case PubkeyAddressPrefixMain, PubkeyAddressPrefixTest:
 		return &BitcoinAddressInfo{
-			Bytes: decoded58[1:],
+			Bytes: decoded58,
 			Type:  PKH,
 		}, nil
-	case ScriptAddressPrefixMain:
+	case ScriptAddressPrefixMain, ScriptAddressPrefixTest:
 		return &BitcoinAddressInfo{
-			Bytes: decoded58[1:],
+			Bytes: decoded58,
 			Type:  SH,
 		}, nil
 	default:
-		return nil, fmt.Errorf("invalid address prefix: %d", decoded58[0])
+		return nil, fmt.Errorf("invalid address prefix: %d", prefix)
 	}
 }
 
 // WrapAddress wraps the address bytes with type and version
 func (ba *BitcoinAddress) WrapAddress(addrType BitcoinAddressType, data []byte, version byte) []byte {
-	if version != 0 {
+	return wrapAddress(addrType, data, version)
+}
+
+// wrapAddress prefixes the hash or witness program with the address type, witness
+// programs always carry their version, v0 included
+func wrapAddress(addrType BitcoinAddressType, data []byte, version byte) []byte {
+	if addrType == WITNESS {
 		result := make([]byte, len(data)+2)
 		result[0] = byte(addrType)
 		result[1] = version
@@ -0,0 +1,174 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package address
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+var (
+	ErrOpReturnScript       = errors.New("OP_RETURN output has no address")
+	ErrNonStandardScript    = errors.New("non standard script")
+	ErrUnknownNetworkPrefix = errors.New("unknown network prefix")
+)
+
+// IsOpReturnScript checks if the scriptPubKey is a provably unspendable data carrier output
+func IsOpReturnScript(script []byte) bool {
+	return len(script) > 0 && script[0] == txscript.OP_RETURN
+}
+
+// BitcoinAddressFromScriptPubKey creates the BitcoinAddress paid to by a P2PKH, P2SH, P2WPKH,
+// P2WSH or P2TR scriptPubKey, the address string is encoded for the given network
+func BitcoinAddressFromScriptPubKey(script []byte, network BitcoinNetworkType) (*BitcoinAddress, error) {
+	if IsOpReturnScript(script) {
+		return nil, ErrOpReturnScript
+	}
+
+	var data []byte
+	switch {
+	case isPubKeyHashScript(script):
+		data = wrapAddress(PKH, script[3:23], 0)
+	case isScriptHashScript(script):
+		data = wrapAddress(SH, script[2:22], 0)
+	case isWitnessScript(script):
+		version, err := witnessVersion(script[0])
+		if err != nil {
+			return nil, err
+		}
+		program := script[2:]
+		if err := validateWitness(version, program); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrNonStandardScript, err)
+		}
+		data = wrapAddress(WITNESS, program, version)
+	default:
+		return nil, ErrNonStandardScript
+	}
+
+	return NewBitcoinAddress(hex.EncodeToString(data), network)
+}
+
+// ScriptPubKey returns the output script paying to the address
+func (ba *BitcoinAddress) ScriptPubKey() ([]byte, error) {
+	if len(ba.bytes) < 2 {
+		return nil, ErrInvalidAddressLen
+	}
+	builder := txscript.NewScriptBuilder()
+	switch BitcoinAddressType(ba.bytes[0]) {
+	case PKH:
+		builder.AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).AddData(ba.bytes[1:]).
+			AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_CHECKSIG)
+	case SH:
+		builder.AddOp(txscript.OP_HASH160).AddData(ba.bytes[1:]).AddOp(txscript.OP_EQUAL)
+	case WITNESS:
+		version := ba.bytes[1]
+		if version == 0 {
+			builder.AddOp(txscript.OP_0)
+		} else {
+			builder.AddOp(txscript.OP_1 + version - 1)
+		}
+		builder.AddData(ba.bytes[2:])
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrInvalidAddressType, ba.bytes[0])
+	}
+	return builder.Script()
+}
+
+// InferBitcoinNetwork returns the network of an address string from its bech32 or base58 prefix,
+// testnet, signet and regtest share the base58 prefixes and testnet is returned for them
+func InferBitcoinNetwork(input string) (BitcoinNetworkType, error) {
+	ba := &BitcoinAddress{rawAddress: input}
+	if _, err := ba.Decode(); err != nil {
+		return 0, err
+	}
+	if network := networkFromAddress(input); network != nil {
+		return network.network, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownNetworkPrefix, input)
+}
+
+// resolveNetwork returns the network of an address string, the given network is kept when
+// the address prefix is shared with it
+func resolveNetwork(input string, network BitcoinNetworkType) BitcoinNetworkType {
+	inferred := networkFromAddress(input)
+	if inferred == nil {
+		return network
+	}
+	if inferred.network == BitcoinNetworkBitcoin || network == BitcoinNetworkBitcoin {
+		return inferred.network
+	}
+	// Testnet, signet and regtest share the base58 prefixes, testnet and signet the bech32 one
+	if _, ok := bech32HRP(input); ok && inferred.Bech32HRP() != NewBitcoinNetwork(network).Bech32HRP() {
+		return inferred.network
+	}
+	return network
+}
+
+// networkFromAddress returns the network named by the address prefix, nil when unknown
+func networkFromAddress(input string) *BitcoinNetwork {
+	if hrp, ok := bech32HRP(input); ok {
+		return FromBech32Prefix(hrp)
+	}
+	if len(input) == 0 {
+		return nil
+	}
+	switch input[0] {
+	case '1', '3':
+		return NewBitcoinNetwork(BitcoinNetworkBitcoin)
+	case 'm', 'n', '2':
+		return NewBitcoinNetwork(BitcoinNetworkTestnet)
+	default:
+		return nil
+	}
+}
+
+func bech32HRP(input string) (string, bool) {
+	hrp, _, err := bech32.Decode(input)
+	if err != nil {
+		return "", false
+	}
+	return hrp, true
+}
+
+func isPubKeyHashScript(script []byte) bool {
+	return len(script) == 25 &&
+		script[0] == txscript.OP_DUP &&
+		script[1] == txscript.OP_HASH160 &&
+		script[2] == txscript.OP_DATA_20 &&
+		script[23] == txscript.OP_EQUALVERIFY &&
+		script[24] == txscript.OP_CHECKSIG
+}
+
+func isScriptHashScript(script []byte) bool {
+	return len(script) == 23 &&
+		script[0] == txscript.OP_HASH160 &&
+		script[1] == txscript.OP_DATA_20 &&
+		script[22] == txscript.OP_EQUAL
+}
+
+func isWitnessScript(script []byte) bool {
+	// A version opcode followed by a single push of 2 to 40 bytes
+	if len(script) < 4 || len(script) > 42 {
+		return false
+	}
+	if _, err := witnessVersion(script[0]); err != nil {
+		return false
+	}
+	return int(script[1]) == len(script)-2
+}
+
+func witnessVersion(op byte) (byte, error) {
+	switch {
+	case op == txscript.OP_0:
+		return 0, nil
+	case op >= txscript.OP_1 && op <= txscript.OP_16:
+		return op - txscript.OP_1 + 1, nil
+	default:
+		return 0, ErrNonStandardScript
+	}
+}
@@ -1,68 +1,183 @@
 package address
 
 import (
-//"testing"
+	"encoding/hex"
+	"testing"
 
-// "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/assert"
 )
 
-//type TestCase struct {
-//	BtcAddr   string
-//	RoochAddr string
-//	HexAddr   string
-//}
-//
-//var testCases = []TestCase{
-//	{
-//		BtcAddr:   "18cBEMRxXHqzWWCxZNtU91F5sbUNKhL5PX",
-//		RoochAddr: "rooch1gxterelcypsyvh8cc9kg73dtnyct822ykx8pmu383qruzt4r93jshtc9fj",
-//		HexAddr:   "0x419791e7f82060465cf8c16c8f45ab9930b3a944b18e1df2278807c12ea32c65",
-//	},
-//	{
-//		BtcAddr:   "bc1q262qeyyhdakrje5qaux8m2a3r4z8sw8vu5mysh",
-//		RoochAddr: "rooch10lnft7hhq37vl0y97lwvkmzqt48fk76y0z88rfcu8zg6qm8qegfqx0rq2h",
-//		HexAddr:   "0x7fe695faf7047ccfbc85f7dccb6c405d4e9b7b44788e71a71c3891a06ce0ca12",
-//	},
-//}
-//
-//func TestBitcoinAddress(t *testing.T) {
-//	t.Run("New address with ed25519 keypair", func(t *testing.T) {
-//		kp := keypairs.GenerateEd25519Keypair()
-//		address := kp.GetPublicKey().ToAddress()
-//		assert.NotNil(t, address)
-//	})
-//
-//	t.Run("From address", func(t *testing.T) {
-//		btcAddr := testCases[0].BtcAddr
-//		addr, err := NewBitcoinAddress(btcAddr)
-//		assert.NoError(t, err)
-//		assert.NotNil(t, addr)
-//	})
-//
-//	t.Run("To rooch address", func(t *testing.T) {
-//		for _, item := range testCases {
-//			addr, err := NewBitcoinAddress(item.BtcAddr)
-//			assert.NoError(t, err)
-//
-//			roochAddr := addr.GenRoochAddress()
-//
-//			genRoochAddr := roochAddr.ToBech32Address()
-//			genRoochHexAddr := roochAddr.ToHexAddress()
-//
-//			assert.True(t, IsValidAddress(genRoochAddr))
-//			assert.True(t, IsValidAddress(genRoochHexAddr))
-//			assert.Equal(t, item.RoochAddr, genRoochAddr)
-//			assert.Equal(t, item.HexAddr, genRoochHexAddr)
-//		}
-//	})
-//
-//	t.Run("From hex address", func(t *testing.T) {
-//		hexAddr := "020145966003624094dae2deeb30815eedd38f96c45c3fdb1261f5d697fc4137e0de"
-//		expectBTCAddr := "bc1pgktxqqmzgz2d4ck7avcgzhhd6w8ed3zu8ld3yc0466tlcsfhur0qj3y0wm"
-//
-//		btcAddr, err := NewBitcoinAddress(hexAddr)
-//		assert.NoError(t, err)
-//
-//		assert.Equal(t, expectBTCAddr, btcAddr.ToStr())
-//	})
-//}
+type TestCase struct {
+	BtcAddr string
+	HexAddr string
+}
+
+var testCases = []TestCase{
+	{
+		BtcAddr: "18cBEMRxXHqzWWCxZNtU91F5sbUNKhL5PX",
+		HexAddr: "0x419791e7f82060465cf8c16c8f45ab9930b3a944b18e1df2278807c12ea32c65",
+	},
+	{
+		BtcAddr: "bc1q262qeyyhdakrje5qaux8m2a3r4z8sw8vu5mysh",
+		HexAddr: "0x7fe695faf7047ccfbc85f7dccb6c405d4e9b7b44788e71a71c3891a06ce0ca12",
+	},
+}
+
+func TestBitcoinAddress(t *testing.T) {
+	t.Run("To rooch address", func(t *testing.T) {
+		for _, item := range testCases {
+			addr, err := NewBitcoinAddress(item.BtcAddr, BitcoinNetworkBitcoin)
+			assert.NoError(t, err)
+
+			roochAddr, err := addr.GenRoochAddress()
+			assert.NoError(t, err)
+			assert.Equal(t, item.HexAddr, roochAddr.String())
+		}
+	})
+
+	t.Run("From hex address", func(t *testing.T) {
+		hexAddr := "020145966003624094dae2deeb30815eedd38f96c45c3fdb1261f5d697fc4137e0de"
+		expectBTCAddr := "bc1pgktxqqmzgz2d4ck7avcgzhhd6w8ed3zu8ld3yc0466tlcsfhur0qj3y0wm"
+
+		btcAddr, err := NewBitcoinAddress(hexAddr, BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, expectBTCAddr, btcAddr.String())
+	})
+
+	t.Run("Hex round trip", func(t *testing.T) {
+		for _, item := range testCases {
+			addr, _ := NewBitcoinAddress(item.BtcAddr, BitcoinNetworkBitcoin)
+			fromHex, err := NewBitcoinAddress(hex.EncodeToString(addr.ToMultiChainAddress().RawAddress), BitcoinNetworkBitcoin)
+			assert.NoError(t, err)
+			assert.Equal(t, item.BtcAddr, fromHex.String())
+		}
+	})
+}
+
+func TestBitcoinAddressFromPublicKey(t *testing.T) {
+	// BIP-44, BIP-49, BIP-84 and BIP-86 test vectors of the "abandon ... about" mnemonic
+	t.Run("P2PKH", func(t *testing.T) {
+		publicKey, _ := hex.DecodeString("03aaeb52dd7494c361049de67cc680e83ebcbbbdbeb13637d92cd845f70308af5e")
+		addr, err := BitcoinAddressFromPublicKeyWithType(publicKey, P2PKH, BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", addr.String())
+	})
+
+	t.Run("P2SH-P2WPKH", func(t *testing.T) {
+		publicKey, _ := hex.DecodeString("03a1af804ac108a8a51782198c2d034b28bf90c8803f5a53f76276fa69a4eae77f")
+		addr, err := BitcoinAddressFromPublicKeyWithType(publicKey, P2SHP2WPKH, BitcoinNetworkTestnet)
+		assert.NoError(t, err)
+		assert.Equal(t, "2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2", addr.String())
+	})
+
+	t.Run("P2WPKH", func(t *testing.T) {
+		publicKey, _ := hex.DecodeString("0330d54fd0dd420a6e5f8d3624f5f3482cae350f79d5f0753bf5beef9c2d91af3c")
+		addr, err := BitcoinAddressFromPublicKeyWithType(publicKey, P2WPKH, BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", addr.String())
+
+		regtest, _ := BitcoinAddressFromPublicKeyWithType(publicKey, P2WPKH, BitcoinNetworkRegtest)
+		assert.Equal(t, "bcrt1qcr8te4kr609gcawutmrza0j4xv80jy8zeqchgx", regtest.String())
+		assert.Equal(t, BitcoinNetworkRegtest, regtest.Network())
+	})
+
+	t.Run("P2TR", func(t *testing.T) {
+		xOnly, _ := hex.DecodeString("cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+		expect := "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"
+		addr, err := BitcoinAddressFromPublicKey(xOnly, BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, expect, addr.String())
+
+		// Both parities of the compressed key share the x-only key, and so the address
+		for _, parity := range []byte{0x02, 0x03} {
+			addr, err := BitcoinAddressFromPublicKey(append([]byte{parity}, xOnly...), BitcoinNetworkBitcoin)
+			assert.NoError(t, err)
+			assert.Equal(t, expect, addr.String())
+		}
+
+		_, err = BitcoinAddressFromPublicKeyWithType(xOnly, P2WPKH, BitcoinNetworkBitcoin)
+		assert.Error(t, err)
+	})
+
+	t.Run("Address view network", func(t *testing.T) {
+		xOnly, _ := hex.DecodeString("cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+		// The network defaults to regtest, other networks are opted in
+		regtest, err := NewAddressView(xOnly)
+		assert.NoError(t, err)
+		assert.Equal(t, BitcoinNetworkRegtest, regtest.Network())
+		assert.Equal(t, "bcrt1p", regtest.BitcoinAddress.String()[:6])
+
+		mainnet, err := NewAddressViewWithNetwork(xOnly, BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, BitcoinNetworkBitcoin, mainnet.Network())
+		assert.Equal(t, "bc1p", mainnet.BitcoinAddress.String()[:4])
+
+		testnet, err := NewAddressViewWithNetwork(xOnly, BitcoinNetworkTestnet)
+		assert.NoError(t, err)
+		assert.Equal(t, "tb1p", testnet.BitcoinAddress.String()[:4])
+		assert.Equal(t, mainnet.RoochAddress, testnet.RoochAddress)
+		assert.Equal(t, mainnet.RoochAddress, regtest.RoochAddress)
+	})
+}
+
+func TestBitcoinScriptPubKey(t *testing.T) {
+	scriptCases := []struct {
+		name    string
+		address string
+		script  string
+	}{
+		{"P2PKH", "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", "76a914d986ed01b7a22225a70edbf2ba7cfb63a15cb3aa88ac"},
+		{"P2SH", "3JvL6Ymt8MVWiCNHC7oWU6nLeHNJKLZGLN", "a914bcfeb728b584253d5f3f70bcb780e9ef218a68f487"},
+		{"P2WPKH", "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", "0014c0cebcd6c3d3ca8c75dc5ec62ebe55330ef910e2"},
+		{"P2WSH", "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"},
+		{"P2TR", "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", "5120a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c"},
+	}
+	for _, item := range scriptCases {
+		t.Run(item.name, func(t *testing.T) {
+			script, _ := hex.DecodeString(item.script)
+			addr, err := BitcoinAddressFromScriptPubKey(script, BitcoinNetworkBitcoin)
+			assert.NoError(t, err)
+			assert.Equal(t, item.address, addr.String())
+
+			parsed, err := NewBitcoinAddress(item.address, BitcoinNetworkBitcoin)
+			assert.NoError(t, err)
+			scriptPubKey, err := parsed.ScriptPubKey()
+			assert.NoError(t, err)
+			assert.Equal(t, item.script, hex.EncodeToString(scriptPubKey))
+		})
+	}
+
+	t.Run("OP_RETURN and non standard", func(t *testing.T) {
+		opReturn, _ := hex.DecodeString("6a0b68656c6c6f20776f726c64")
+		assert.True(t, IsOpReturnScript(opReturn))
+		_, err := BitcoinAddressFromScriptPubKey(opReturn, BitcoinNetworkBitcoin)
+		assert.ErrorIs(t, err, ErrOpReturnScript)
+
+		_, err = BitcoinAddressFromScriptPubKey([]byte{0x51}, BitcoinNetworkBitcoin)
+		assert.ErrorIs(t, err, ErrNonStandardScript)
+	})
+}
+
+func TestInferBitcoinNetwork(t *testing.T) {
+	networkCases := map[string]BitcoinNetworkType{
+		"bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu":   BitcoinNetworkBitcoin,
+		"bcrt1qcr8te4kr609gcawutmrza0j4xv80jy8zeqchgx": BitcoinNetworkRegtest,
+		"2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2":          BitcoinNetworkTestnet,
+		"1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA":           BitcoinNetworkBitcoin,
+	}
+	for input, expect := range networkCases {
+		network, err := InferBitcoinNetwork(input)
+		assert.NoError(t, err)
+		assert.Equal(t, expect, network)
+	}
+
+	t.Run("Address keeps a compatible network", func(t *testing.T) {
+		addr, err := NewBitcoinAddress("2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2", BitcoinNetworkRegtest)
+		assert.NoError(t, err)
+		assert.Equal(t, BitcoinNetworkRegtest, addr.Network())
+
+		addr, err = NewBitcoinAddress("bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", BitcoinNetworkRegtest)
+		assert.NoError(t, err)
+		assert.Equal(t, BitcoinNetworkBitcoin, addr.Network())
+	})
+}
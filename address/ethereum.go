@@ -0,0 +1,87 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package address
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const EthereumAddressLength = common.AddressLength
+
+// EthereumAddress represents a 20-byte Ethereum account address
+type EthereumAddress struct {
+	address [EthereumAddressLength]byte
+}
+
+// NewEthereumAddress creates an EthereumAddress from a hex string, mixed case input must carry a valid EIP-55 checksum
+func NewEthereumAddress(input string) (*EthereumAddress, error) {
+	if !common.IsHexAddress(input) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, input)
+	}
+	addr := common.HexToAddress(input)
+	stripped := stripHexPrefix(input)
+	if stripped != addr.Hex()[2:] && !isSingleCase(stripped) {
+		return nil, fmt.Errorf("%w: bad EIP-55 checksum %s", ErrInvalidAddress, input)
+	}
+	return &EthereumAddress{address: addr}, nil
+}
+
+// NewEthereumAddressFromBytes creates an EthereumAddress from its 20 raw bytes
+func NewEthereumAddressFromBytes(bytes []byte) (*EthereumAddress, error) {
+	if len(bytes) != EthereumAddressLength {
+		return nil, ErrInvalidAddressLen
+	}
+	return &EthereumAddress{address: [EthereumAddressLength]byte(bytes)}, nil
+}
+
+// EthereumAddressFromPublicKey derives the EthereumAddress of a compressed (33 bytes) or uncompressed (65 bytes) secp256k1 public key
+func EthereumAddressFromPublicKey(publicKey []byte) (*EthereumAddress, error) {
+	switch len(publicKey) {
+	case 33:
+		pk, err := ethCrypto.DecompressPubkey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		return &EthereumAddress{address: ethCrypto.PubkeyToAddress(*pk)}, nil
+	case 65:
+		pk, err := ethCrypto.UnmarshalPubkey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		return &EthereumAddress{address: ethCrypto.PubkeyToAddress(*pk)}, nil
+	default:
+		return nil, fmt.Errorf("invalid public key length %d", len(publicKey))
+	}
+}
+
+// String returns the EIP-55 checksummed representation
+func (ea *EthereumAddress) String() string {
+	return common.Address(ea.address).Hex()
+}
+
+// ToBytes returns the 20 raw bytes of the address
+func (ea *EthereumAddress) ToBytes() []byte {
+	return ea.address[:]
+}
+
+// ToMultiChainAddress wraps the address with the Ethereum chain ID
+func (ea *EthereumAddress) ToMultiChainAddress() *MultiChainAddress {
+	return &MultiChainAddress{
+		MultiChainID: MultiChainIDEther,
+		RawAddress:   ea.ToBytes(),
+	}
+}
+
+// GenRoochAddress generates the Rooch address mapped to the Ethereum address
+func (ea *EthereumAddress) GenRoochAddress() (*RoochAddress, error) {
+	return ea.ToMultiChainAddress().ToRoochAddress()
+}
+
+func isSingleCase(s string) bool {
+	return s == strings.ToLower(s) || s == strings.ToUpper(s)
+}
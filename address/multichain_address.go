@@ -0,0 +1,155 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package address
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+// Multi chain IDs, mirrors types.MultiChainIDVariant
+const (
+	MultiChainIDBitcoin uint64 = 0
+	MultiChainIDEther   uint64 = 60
+	MultiChainIDNostr   uint64 = 1237
+	MultiChainIDRooch   uint64 = 20230101
+)
+
+//pub struct MultiChainAddress {
+//pub multichain_id: RoochMultiChainID,
+//pub raw_address: Vec<u8>,
+//}
+
+// MultiChainAddress is an address of any chain known to Rooch, as taken by the
+// 0x3::address_mapping Move functions
+//
+// Implements:
+//   - [bcs.Marshaler]
+//   - [bcs.Unmarshaler]
+type MultiChainAddress struct {
+	MultiChainID uint64
+	RawAddress   []byte
+}
+
+// ChainAddress is implemented by every typed address a [MultiChainAddress] can carry
+type ChainAddress interface {
+	ToMultiChainAddress() *MultiChainAddress
+	String() string
+}
+
+// NewMultiChainAddress creates a MultiChainAddress from a chain ID and the raw address bytes
+func NewMultiChainAddress(multiChainID uint64, rawAddress []byte) (*MultiChainAddress, error) {
+	mca := &MultiChainAddress{MultiChainID: multiChainID, RawAddress: rawAddress}
+	if _, err := mca.ToChainAddress(BitcoinNetworkBitcoin); err != nil {
+		return nil, err
+	}
+	return mca, nil
+}
+
+// ParseMultiChainAddress creates a MultiChainAddress from the native string form of an address on the given chain
+func ParseMultiChainAddress(multiChainID uint64, input string, network BitcoinNetworkType) (*MultiChainAddress, error) {
+	switch multiChainID {
+	case MultiChainIDBitcoin:
+		addr, err := NewBitcoinAddress(input, network)
+		if err != nil {
+			return nil, err
+		}
+		return addr.ToMultiChainAddress(), nil
+	case MultiChainIDEther:
+		addr, err := NewEthereumAddress(input)
+		if err != nil {
+			return nil, err
+		}
+		return addr.ToMultiChainAddress(), nil
+	case MultiChainIDNostr:
+		addr, err := NewNostrAddress(input)
+		if err != nil {
+			return nil, err
+		}
+		return addr.ToMultiChainAddress(), nil
+	case MultiChainIDRooch:
+		addr, err := NewRoochAddress(input)
+		if err != nil {
+			return nil, err
+		}
+		return addr.ToMultiChainAddress(), nil
+	default:
+		return nil, fmt.Errorf("unsupported multi chain id %d", multiChainID)
+	}
+}
+
+// ToChainAddress decodes the raw address into its typed form, network is only used for Bitcoin
+func (mca *MultiChainAddress) ToChainAddress(network BitcoinNetworkType) (ChainAddress, error) {
+	switch mca.MultiChainID {
+	case MultiChainIDBitcoin:
+		if len(mca.RawAddress) < 2 {
+			return nil, ErrInvalidAddressLen
+		}
+		return NewBitcoinAddress(hex.EncodeToString(mca.RawAddress), network)
+	case MultiChainIDEther:
+		return NewEthereumAddressFromBytes(mca.RawAddress)
+	case MultiChainIDNostr:
+		return NewNostrAddress(mca.RawAddress)
+	case MultiChainIDRooch:
+		return NewRoochAddressFromBytes(mca.RawAddress)
+	default:
+		return nil, fmt.Errorf("unsupported multi chain id %d", mca.MultiChainID)
+	}
+}
+
+// ToRoochAddress returns the Rooch address the chain address maps to
+func (mca *MultiChainAddress) ToRoochAddress() (*RoochAddress, error) {
+	switch mca.MultiChainID {
+	case MultiChainIDRooch:
+		return NewRoochAddressFromBytes(mca.RawAddress)
+	case MultiChainIDBitcoin, MultiChainIDEther:
+		return NewRoochAddressFromBytes(utils.Blake2b256(mca.RawAddress))
+	case MultiChainIDNostr:
+		nostrAddress, err := NewNostrAddress(mca.RawAddress)
+		if err != nil {
+			return nil, err
+		}
+		return nostrAddress.GenRoochAddress()
+	default:
+		return nil, fmt.Errorf("unsupported multi chain id %d", mca.MultiChainID)
+	}
+}
+
+// String returns the native string form of the address, Bitcoin addresses are shown for mainnet
+func (mca *MultiChainAddress) String() string {
+	chainAddress, err := mca.ToChainAddress(BitcoinNetworkBitcoin)
+	if err != nil {
+		return fmt.Sprintf("%d:0x%s", mca.MultiChainID, hex.EncodeToString(mca.RawAddress))
+	}
+	return chainAddress.String()
+}
+
+// ToBytes returns the BCS encoded MultiChainAddress
+func (mca *MultiChainAddress) ToBytes() ([]byte, error) {
+	return bcs.Serialize(mca)
+}
+
+// MultiChainAddressFromBytes decodes a BCS encoded MultiChainAddress
+func MultiChainAddressFromBytes(data []byte) (*MultiChainAddress, error) {
+	mca := &MultiChainAddress{}
+	if err := bcs.Deserialize(mca, data); err != nil {
+		return nil, err
+	}
+	return mca, nil
+}
+
+// MarshalBCS Converts the MultiChainAddress to BCS encoded bytes
+func (mca *MultiChainAddress) MarshalBCS(ser *bcs.Serializer) {
+	ser.U64(mca.MultiChainID)
+	ser.WriteBytes(mca.RawAddress)
+}
+
+// UnmarshalBCS Converts the MultiChainAddress from BCS encoded bytes
+func (mca *MultiChainAddress) UnmarshalBCS(des *bcs.Deserializer) {
+	mca.MultiChainID = des.U64()
+	mca.RawAddress = des.ReadBytes()
+}
@@ -0,0 +1,59 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiChainAddress(t *testing.T) {
+	t.Run("BCS round trip", func(t *testing.T) {
+		mca := &MultiChainAddress{MultiChainID: MultiChainIDEther, RawAddress: make([]byte, EthereumAddressLength)}
+		bytes, err := mca.ToBytes()
+		assert.NoError(t, err)
+		// u64 chain id, then the length prefixed raw address
+		assert.Equal(t, []byte{60, 0, 0, 0, 0, 0, 0, 0, 20}, bytes[:9])
+		assert.Equal(t, 9+EthereumAddressLength, len(bytes))
+
+		decoded, err := MultiChainAddressFromBytes(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, mca, decoded)
+	})
+
+	t.Run("Bitcoin", func(t *testing.T) {
+		input := "bc1p8xpjpkc9uzj2dexcxjg9sw8lxje85xa4070zpcys589e3rf6k20qm6gjrt"
+		mca, err := ParseMultiChainAddress(MultiChainIDBitcoin, input, BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, input, mca.String())
+
+		bitcoinAddress, _ := NewBitcoinAddress(input, BitcoinNetworkBitcoin)
+		expect, _ := bitcoinAddress.GenRoochAddress()
+		roochAddress, err := mca.ToRoochAddress()
+		assert.NoError(t, err)
+		assert.Equal(t, expect.String(), roochAddress.String())
+
+		encoded, _ := bcs.Serialize(mca)
+		assert.Equal(t, encoded, bitcoinAddress.GenMultiChainAddress())
+	})
+
+	t.Run("Rooch and Ethereum", func(t *testing.T) {
+		mca, err := ParseMultiChainAddress(MultiChainIDRooch, AddressThree.StringLong(), BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		roochAddress, _ := mca.ToRoochAddress()
+		assert.Equal(t, AddressThree, *roochAddress)
+
+		ethereum := "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+		mca, err = ParseMultiChainAddress(MultiChainIDEther, ethereum, BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		chainAddress, err := mca.ToChainAddress(BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.IsType(t, &EthereumAddress{}, chainAddress)
+		assert.Equal(t, ethereum, chainAddress.String())
+	})
+
+	t.Run("Unknown chain", func(t *testing.T) {
+		_, err := NewMultiChainAddress(784, []byte{1})
+		assert.Error(t, err)
+	})
+}
@@ -78,3 +78,16 @@ func (n *NostrAddress) ToStr() string {
 func (n *NostrAddress) ToBytes() []byte {
 	return n.bytes
 }
+
+// String returns the npub representation
+func (n *NostrAddress) String() string {
+	return n.str
+}
+
+// ToMultiChainAddress wraps the public key with the Nostr chain ID
+func (n *NostrAddress) ToMultiChainAddress() *MultiChainAddress {
+	return &MultiChainAddress{
+		MultiChainID: MultiChainIDNostr,
+		RawAddress:   n.bytes,
+	}
+}
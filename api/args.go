@@ -165,6 +165,15 @@ func ArgAddress(input interface{}) (*Args, error) {
 	return NewArgs(bytes), nil
 }
 
+// Consistent with JS static multiChainAddress()
+func ArgMultiChainAddress(input *address.MultiChainAddress) (*Args, error) {
+	bytes, err := input.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return NewArgs(bytes), nil
+}
+
 // Consistent with JS static object()
 func ArgObject(input types.StructTag) (*Args, error) {
 	objectID, err := types.StructTagToObjectID(&input)
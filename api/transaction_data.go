@@ -4,6 +4,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"github.com/rooch-network/rooch-go-sdk/bcs"
 	"github.com/rooch-network/rooch-go-sdk/types"
@@ -119,8 +120,13 @@ func NewCallFunction(input CallFunctionArgs) *CallFunction {
 }
 
 func (c *CallFunction) FunctionId() string {
+	moduleAddress, err := address.NormalizeRoochAddressStrict(c.Address, true)
+	if err != nil {
+		// The node reports the invalid address, ToFunctionCall returns the error
+		moduleAddress = c.Address
+	}
 	return fmt.Sprintf("%s::%s::%s",
-		address.NormalizeRoochAddress(c.Address, true),
+		moduleAddress,
 		c.Module,
 		c.Function)
 }
@@ -147,9 +153,11 @@ type MoveActionType interface {
 
 //type MoveActionType int
 
+// MoveActionVariant* are the variants of the Rust MoveAction enum, see [types.MoveActionVariant]
 const (
-	MoveActionVariantFunction int = 1
-	MoveActionVariantScript   int = 2
+	MoveActionVariantScript       = int(types.MoveActionVariantScript)
+	MoveActionVariantFunction     = int(types.MoveActionVariantFunction)
+	MoveActionVariantModuleBundle = int(types.MoveActionVariantModuleBundle)
 )
 
 //type MoveActionImpl interface {
@@ -159,8 +167,14 @@ const (
 //func (*CallFunction) MoveActionType() MoveActionType { return MoveActionVariantScript }
 //func (*CallScript) MoveActionType() MoveActionType   { return MoveActionVariantFunction }
 
+// ModuleBundle publishes the compiled modules
+type ModuleBundle struct {
+	Modules [][]byte `json:"modules"`
+}
+
 func (*CallFunction) isActionType() {}
 func (*CallScript) isActionType()   {}
+func (*ModuleBundle) isActionType() {}
 
 type MoveAction struct {
 	Scheme int
@@ -181,6 +195,94 @@ func NewCallScriptAction(input *CallScript) *MoveAction {
 	}
 }
 
+func NewModuleBundleAction(modules [][]byte) *MoveAction {
+	return &MoveAction{
+		Scheme: MoveActionVariantModuleBundle,
+		Val:    &ModuleBundle{Modules: modules},
+	}
+}
+
+// ToFunctionCall converts the call to its BCS form, type args are parsed with [ParseTypeTagFromStr]
+func (c *CallFunction) ToFunctionCall() (*types.FunctionCall, error) {
+	moduleAddress, err := address.ParseRoochAddress(c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function address %s: %w", c.Address, err)
+	}
+	typeArgs, err := parseTypeArgs(c.TypeArgs)
+	if err != nil {
+		return nil, err
+	}
+	return &types.FunctionCall{
+		FunctionId: types.FunctionId{
+			ModuleId: types.ModuleId{
+				Address: *moduleAddress,
+				Name:    c.Module,
+			},
+			FunctionName: types.Identifier(c.Function),
+		},
+		TypeArgs: typeArgs,
+		Args:     c.EncodeArgsToByteArrays(),
+	}, nil
+}
+
+// ToScriptCall converts the script to its BCS form, Code is the hex of the compiled script
+func (c *CallScript) ToScriptCall() (*types.ScriptCall, error) {
+	code, err := utils.HexToBytes(c.Code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script code: %w", err)
+	}
+	typeArgs, err := parseTypeArgs(c.TypeArgs)
+	if err != nil {
+		return nil, err
+	}
+	args := make([][]byte, len(c.Args))
+	for i, arg := range c.Args {
+		args[i] = arg.Encode()
+	}
+	return &types.ScriptCall{
+		Code:   code,
+		TyArgs: typeArgs,
+		Args:   args,
+	}, nil
+}
+
+// ToMoveAction converts the action to its BCS form
+func (ma *MoveAction) ToMoveAction() (*types.MoveAction, error) {
+	if ma == nil || ma.Val == nil {
+		return nil, errors.New("move action is nil")
+	}
+	var (
+		action types.MoveActionImpl
+		err    error
+	)
+	switch val := ma.Val.(type) {
+	case *CallFunction:
+		action, err = val.ToFunctionCall()
+	case *CallScript:
+		action, err = val.ToScriptCall()
+	case *ModuleBundle:
+		action = &types.ModuleBundle{Value: val.Modules}
+	default:
+		return nil, fmt.Errorf("unsupported move action %T", ma.Val)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.MoveAction{Action: action}, nil
+}
+
+func parseTypeArgs(typeArgs []string) ([]types.TypeTag, error) {
+	result := make([]types.TypeTag, len(typeArgs))
+	for i, typeArg := range typeArgs {
+		typeTag, err := ParseTypeTagFromStr(typeArg, true)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = typeTag
+	}
+	return result, nil
+}
+
 type TransactionData struct {
 	Sender         *types.RoochAddress
 	SequenceNumber *uint64
@@ -218,31 +320,43 @@ func NewTransactionData(
 	}, nil
 }
 
-func (t *TransactionData) Encode() []byte {
-	call := t.Action.Val.(*CallFunction)
-
-	data := bcs.RoochTransactionData{
+// ToTransactionData converts to [types.TransactionData], the BCS model of the Rust rooch_types::TransactionData
+func (t *TransactionData) ToTransactionData() (*types.TransactionData, error) {
+	if t.Sender == nil {
+		return nil, errors.New("transaction sender is not set")
+	}
+	if t.SequenceNumber == nil {
+		return nil, errors.New("transaction sequence number is not set")
+	}
+	if t.ChainId == nil {
+		return nil, errors.New("transaction chain id is not set")
+	}
+	action, err := t.Action.ToMoveAction()
+	if err != nil {
+		return nil, err
+	}
+	return &types.TransactionData{
 		Sender:         *t.Sender,
 		SequenceNumber: *t.SequenceNumber,
 		ChainId:        *t.ChainId,
-		MaxGas:         t.MaxGas,
-		Action: bcs.TransactionAction{
-			Kind: "CallFunction",
-			FunctionId: bcs.FunctionId{
-				ModuleId: bcs.ModuleId{
-					Address: call.Address,
-					Name:    call.Module,
-				},
-				Name: call.Function,
-			},
-			Args:     call.EncodeArgsToByteArrays(),
-			TypeArgs: call.TypeArgs,
-		},
-	}
+		MaxGasAmount:   t.MaxGas,
+		Action:         *action,
+	}, nil
+}
 
-	return data.Serialize()
+// Encode returns the BCS bytes of the transaction data, which are signed through [TransactionData.Hash]
+func (t *TransactionData) Encode() ([]byte, error) {
+	data, err := t.ToTransactionData()
+	if err != nil {
+		return nil, err
+	}
+	return bcs.Serialize(data)
 }
 
-func (t *TransactionData) Hash() []byte {
-	return utils.Sha3256(t.Encode())
+func (t *TransactionData) Hash() ([]byte, error) {
+	data, err := t.ToTransactionData()
+	if err != nil {
+		return nil, err
+	}
+	return data.Hash()
 }
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionDataEncode(t *testing.T) {
+	recipient, _ := ArgAddress("0x42")
+	amount, _ := ArgU256(*big.NewInt(100))
+
+	t.Run("call function matches types.FunctionCall", func(t *testing.T) {
+		action := NewCallFunctionAction(CallFunctionArgs{
+			Target:   "0x3::transfer::transfer_coin",
+			Args:     []Args{*recipient, *amount},
+			TypeArgs: []string{"0x3::gas_coin::RGas"},
+		})
+		data, err := NewTransactionData(action, "0x42", 1, 4, 0)
+		assert.NoError(t, err)
+		encoded, err := data.Encode()
+		assert.NoError(t, err)
+
+		expected, err := data.ToTransactionData()
+		assert.NoError(t, err)
+		call := expected.Action.Action.(*types.FunctionCall)
+		assert.Equal(t, "0x3::gas_coin::RGas", call.TypeArgs[0].String())
+		assert.Equal(t, [][]byte{recipient.Encode(), amount.Encode()}, call.Args)
+		expectedBytes, err := bcs.Serialize(expected)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedBytes, encoded)
+
+		decoded := &types.TransactionData{}
+		assert.NoError(t, bcs.Deserialize(decoded, encoded))
+		assert.Equal(t, DEFAULT_GAS, decoded.MaxGasAmount)
+
+		hash, err := data.Hash()
+		assert.NoError(t, err)
+		expectedHash, _ := expected.Hash()
+		assert.Equal(t, expectedHash, hash)
+	})
+
+	t.Run("call script and module bundle", func(t *testing.T) {
+		script := NewCallScriptAction(&CallScript{Code: "0xa11ceb0b", Args: []Args{*recipient}, TypeArgs: []string{"u64"}})
+		data, err := NewTransactionData(script, "0x42", 0, 4, 0)
+		assert.NoError(t, err)
+		encoded, err := data.Encode()
+		assert.NoError(t, err)
+		decoded := &types.TransactionData{}
+		assert.NoError(t, bcs.Deserialize(decoded, encoded))
+		assert.Equal(t, []byte{0xa1, 0x1c, 0xeb, 0x0b}, decoded.Action.Action.(*types.ScriptCall).Code)
+
+		bundle := NewModuleBundleAction([][]byte{{0xa1, 0x1c, 0xeb, 0x0b}})
+		data, err = NewTransactionData(bundle, "0x42", 0, 4, 0)
+		assert.NoError(t, err)
+		encoded, err = data.Encode()
+		assert.NoError(t, err)
+		assert.NoError(t, bcs.Deserialize(decoded, encoded))
+		assert.Equal(t, types.MoveActionVariantModuleBundle, decoded.Action.Action.MoveActionType())
+	})
+
+	t.Run("invalid transaction data", func(t *testing.T) {
+		action := NewCallFunctionAction(CallFunctionArgs{Target: "0x3::empty::empty", TypeArgs: []string{"not a type"}})
+		data, _ := NewTransactionData(action, "0x42", 0, 4, 0)
+		_, err := data.Encode()
+		assert.Error(t, err)
+
+		data, _ = NewTransactionData(NewCallFunctionAction(CallFunctionArgs{Target: "0x3::empty::empty"}), "", 0, 4, 0)
+		_, err = data.Encode()
+		assert.Error(t, err)
+	})
+}
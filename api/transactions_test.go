@@ -2,209 +2,111 @@ package api
 
 import (
 	"encoding/json"
-	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
 )
 
-func TestTransaction_UserTransaction(t *testing.T) {
+func TestTransaction_L1Block(t *testing.T) {
 	testJson := `{
-  "version": "1010733903",
-  "hash": "0xae3f1f751c6cacd61f46054a5e9e39ca9f094802875befbc54ceecbcdf6eff69",
-  "state_change_hash": "0x3e8340786d2085a2160fa368c380ed412d4a5a3c5ccad692092c4bc0074fde3e",
-  "event_root_hash": "0xe6e2ae41a57d9ab1c7dc58851d7beb4d5be43797ba7225d3e2a3b69c35fe7c2d",
-  "state_checkpoint_hash": null,
-  "gas_used": "5",
-  "success": true,
-  "vm_status": "Executed successfully",
-  "accumulator_root_hash": "0xf9fdaddf6051311cb54e3756a343faa346f1c9137370762f6eef8e375a7031bb",
-  "changes": [
-  {
-    "address": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572",
-    "state_key_hash": "0xb59b40ac86b159eee2c76ff2eb121b91aa8638ef806d08ed6e061bd60c9b134d",
-    "data": {
-      "type": "0x1::object::ObjectCore",
-      "data": {
-        "allow_ungated_transfer": true,
-        "guid_creation_num": "1125899906842626",
-        "owner": "0x8038df5e61a19a5f86ad01f4389736b08250dad1b4aa864afc4fc639a2581ca8",
-        "transfer_events": {
-          "counter": "1",
-          "guid": {
-            "id": {
-              "addr": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572",
-              "creation_num": "1125899906842624"
-            }
-          }
-        }
-      }
-    },
-    "type": "write_resource"
-  },
-  {
-    "address": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572",
-    "state_key_hash": "0xb59b40ac86b159eee2c76ff2eb121b91aa8638ef806d08ed6e061bd60c9b134d",
-    "data": {
-      "type": "0x3::gas_coin::RGas",
-      "data": {
-        "burn_ref": {
-          "vec": [
-          {
-            "inner": {
-              "vec": [
-              {
-                "self": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572"
-              }
-              ]
-            },
-            "self": {
-              "vec": []
-            }
-          }
-          ]
-        },
-        "mutator_ref": {
-          "vec": [
-          {
-            "self": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572"
-          }
-          ]
-        },
-        "property_mutator_ref": {
-          "self": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572"
-        },
-        "transfer_ref": {
-          "vec": [
-          {
-            "self": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572"
-          }
-          ]
-        }
-      }
-    },
-    "type": "write_resource"
-  },
-  {
-    "address": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572",
-    "state_key_hash": "0xb59b40ac86b159eee2c76ff2eb121b91aa8638ef806d08ed6e061bd60c9b134d",
+  "transaction": {
     "data": {
-      "type": "0x4::property_map::PropertyMap",
-      "data": {
-        "inner": {
-	      "data": []
-        }
-      }
-    },
-    "type": "write_resource"
-  },
-  {
-    "address": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572",
-    "state_key_hash": "0xb59b40ac86b159eee2c76ff2eb121b91aa8638ef806d08ed6e061bd60c9b134d",
-    "data": {
-      "type": "0x4::token::Token",
-      "data": {
-        "collection": {
-          "inner": "0x778adb39026a14009cf5aa93eb53d81299e40c7a8dbcdbf7b490cbc29749d259"
-        },
-        "description": "This is BLACK FLAG ARMY NFT",
-        "index": "0",
-        "mutation_events": {
-          "counter": "0",
-          "guid": {
-            "id": {
-              "addr": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572",
-              "creation_num": "1125899906842625"
-            }
-          }
-        },
-        "name": "",
-        "uri": "https://bafybeierhssqdg7fv64xkkjuvsq4bikj2yfmuxm4dvb6jxb2un4yw37ohi.ipfs.w3s.link/68.webp"
-      }
-    },
-    "type": "write_resource"
-  }
-  ],
-  "sender": "0xa46c6c7a65d605685e23055a6a906fb7284ba87849cbeb579d5c07424938241e",
-  "sequence_number": "242217",
-  "max_gas_amount": "2018",
-  "gas_unit_price": "100",
-  "expiration_timestamp_secs": "1719968695",
-  "payload": {
-    "function": "0x1::object::transfer",
-    "type_arguments": [
-      "0x4::token::Token"
-    ],
-    "arguments": [
-    {
-      "inner": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572"
-    },
-    "0x8038df5e61a19a5f86ad01f4389736b08250dad1b4aa864afc4fc639a2581ca8"
-    ],
-    "type": "entry_function_payload"
-  },
-  "signature": {
-    "public_key": "0x5e10e3db4e3c700142b9a3e18c40038db5903f2dedfe41d09aca74a8c68565d6",
-    "signature": "0xa95686dab2c93cf1720e300b929e3656cc6cdc3a8389dc12bb9bd5a17ae3af975bee9d618f080266e3a60f1e2968220a83d773e2b3902edfe54127ed0a7b290b",
-    "type": "ed25519_signature"
-  },
-  "events": [
-  {
-    "guid": {
-      "creation_number": "1125899906842624",
-      "account_address": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572"
+      "type": "l1_block",
+      "chain_id": "1",
+      "block_height": "840000",
+      "block_hash": "0x5fdcaa0e2d0a6e4b8ad6d31a2b98e0d7c0c9b80e6c5f7a2b1e5f2d9f1d1a0000",
+      "bitcoin_block_hash": "00001a1d9f2d5f1e2b7a5f6c0eb8c9c0d7e0982b1ad3d68a4b6e0a2d0eaadc5f"
     },
-    "sequence_number": "0",
-    "type": "0x1::object::TransferEvent",
-    "data": {
-      "from": "0xa46c6c7a65d605685e23055a6a906fb7284ba87849cbeb579d5c07424938241e",
-      "object": "0x2932a152328163661f0ae591911270d0edfe0a765beb48a270b9b8a70e766572",
-      "to": "0x8038df5e61a19a5f86ad01f4389736b08250dad1b4aa864afc4fc639a2581ca8"
+    "sequence_info": {
+      "tx_order": "12",
+      "tx_order_signature": "0x",
+      "tx_accumulator_root": "0x` + strings.Repeat("11", 32) + `",
+      "tx_timestamp": "1713000000000",
+      "tx_accumulator_frozen_subtree_roots": [],
+      "tx_accumulator_num_leaves": "13",
+      "tx_accumulator_num_nodes": "25"
     }
   },
-  {
-    "guid": {
-      "creation_number": "0",
-      "account_address": "0x0"
-    },
-    "sequence_number": "0",
-    "type": "0x1::transaction_fee::FeeStatement",
-    "data": {
-      "execution_gas_units": "3",
-      "io_gas_units": "2",
-      "storage_fee_octas": "0",
-      "storage_fee_refund_octas": "0",
-      "total_charge_gas_units": "5"
-    }
+  "execution_info": {
+    "tx_hash": "0x` + strings.Repeat("22", 32) + `",
+    "state_root": "0x` + strings.Repeat("33", 32) + `",
+    "event_root": "0x` + strings.Repeat("44", 32) + `",
+    "size": "1000",
+    "gas_used": "0",
+    "status": {"type": "executed"}
   }
-  ],
-  "timestamp": "1719965096135309",
-  "type": "user_transaction"
 }`
 	data := &Transaction{}
 	err := json.Unmarshal([]byte(testJson), &data)
 	assert.NoError(t, err)
-	assert.Equal(t, TransactionVariantUser, data.Type)
-	data2 := &CommittedTransaction{}
-	err = json.Unmarshal([]byte(testJson), &data2)
-	assert.NoError(t, err)
-	assert.Equal(t, TransactionVariantUser, data2.Type)
 
-	txn, err := data.UserTransaction()
-	assert.NoError(t, err)
-	txn2, err := data2.UserTransaction()
+	block := data.Transaction.Data.L1Block()
+	assert.NotNil(t, block)
+	assert.Equal(t, uint64(840000), block.BlockHeight)
+	assert.Equal(t, uint64(12), data.Transaction.SequenceInfo.TxOrder)
+	assert.Equal(t, []byte{}, data.Transaction.SequenceInfo.TxOrderSignature)
+	assert.True(t, data.ExecutionInfo.Status.IsExecuted())
+	assert.Equal(t, "0x"+strings.Repeat("22", 32), data.ExecutionInfo.TxHash.String())
+
+	// The decoded transaction round trips through BCS
+	encoded, err := bcs.Serialize(data)
 	assert.NoError(t, err)
-	assert.Equal(t, txn, txn2)
+	decoded := &Transaction{}
+	assert.NoError(t, bcs.Deserialize(decoded, encoded))
+	assert.Equal(t, data, decoded)
+}
 
-	assert.Equal(t, uint64(1010733903), txn.Version)
-	assert.Equal(t, uint64(1719965096135309), txn.Timestamp)
-	assert.Equal(t, uint64(242217), txn.SequenceNumber)
-	assert.Equal(t, uint64(100), txn.GasUnitPrice)
-	assert.Equal(t, uint64(2018), txn.MaxGasAmount)
-	assert.Equal(t, uint64(1719968695), txn.ExpirationTimestampSecs)
+func TestTransactionPage(t *testing.T) {
+	page := &TransactionPage{}
+	err := json.Unmarshal([]byte(`{"data": [], "next_cursor": "41", "has_next_page": true}`), page)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(41), *page.NextCursor)
+	assert.True(t, page.HasNextPage)
 
-	// TODO: test some more
+	err = json.Unmarshal([]byte(`{"data": [], "next_cursor": null, "has_next_page": false}`), page)
+	assert.NoError(t, err)
+	assert.Nil(t, page.NextCursor)
+}
 
-	// Check functions
-	assert.Equal(t, *data.Version(), data2.Version())
-	assert.Equal(t, data.Hash(), data2.Hash())
-	assert.Equal(t, *data.Success(), data2.Success())
+func TestExecuteTransactionResponse(t *testing.T) {
+	testJson := `{
+  "sequence_info": {
+    "tx_order": "3",
+    "tx_order_signature": "0x0102",
+    "tx_accumulator_root": "0x` + strings.Repeat("11", 32) + `",
+    "tx_timestamp": "1713000000000",
+    "tx_accumulator_frozen_subtree_roots": ["0x` + strings.Repeat("aa", 32) + `"],
+    "tx_accumulator_num_leaves": "4",
+    "tx_accumulator_num_nodes": "7"
+  },
+  "execution_info": {
+    "tx_hash": "0x` + strings.Repeat("22", 32) + `",
+    "state_root": "0x` + strings.Repeat("33", 32) + `",
+    "event_root": "0x` + strings.Repeat("44", 32) + `",
+    "size": "1000",
+    "gas_used": "52417",
+    "status": {"type": "moveabort", "location": "0x3::transfer", "abort_code": "1"}
+  },
+  "output": {
+    "status": {"type": "moveabort", "location": "0x3::transfer", "abort_code": "1"},
+    "changeset": {"changes": []},
+    "events": [],
+    "gas_used": "52417",
+    "is_upgrade": false
+  },
+  "error_info": null
+}`
+	data := &ExecuteTransactionResponse{}
+	err := json.Unmarshal([]byte(testJson), data)
+	assert.NoError(t, err)
+	assert.False(t, data.Success())
+	assert.Equal(t, types.KeptVMStatusMoveAbort, data.ExecutionInfo.Status.Type)
+	assert.Equal(t, "0x3::transfer", data.ExecutionInfo.Status.Location.String())
+	assert.Equal(t, uint64(52417), data.Output.GasUsed)
+	assert.Equal(t, []byte{0x01, 0x02}, data.SequenceInfo.TxOrderSignature)
+	assert.Equal(t, "0x"+strings.Repeat("22", 32), data.Hash().String())
+	assert.Nil(t, data.ErrorInfo)
 }
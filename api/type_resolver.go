@@ -0,0 +1,259 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+var (
+	ErrTypeArgsMismatch = errors.New("wrong number of type arguments")
+	ErrFunctionNotFound = errors.New("function not found in module")
+	ErrStructNotFound   = errors.New("struct not found in module")
+)
+
+// ModuleAbiFetcher returns the ABI of a module, e.g. through the rooch_getModuleABI RPC
+type ModuleAbiFetcher func(moduleAddress types.RoochAddress, moduleName string) (*MoveModule, error)
+
+// ParamTypes parses the parameter types of the function, generic type parameters are kept as [types.GenericTag]
+func (f *MoveFunction) ParamTypes() ([]types.TypeTag, error) {
+	return parseAbiTypes(f.Params)
+}
+
+// ReturnTypes parses the return types of the function, generic type parameters are kept as [types.GenericTag]
+func (f *MoveFunction) ReturnTypes() ([]types.TypeTag, error) {
+	return parseAbiTypes(f.Return)
+}
+
+// TypeTag parses the type of the field, generic type parameters are kept as [types.GenericTag]
+func (f *MoveStructField) TypeTag() (*types.TypeTag, error) {
+	return types.ParseAbiTypeTag(f.Type)
+}
+
+func parseAbiTypes(strs []string) ([]types.TypeTag, error) {
+	result := make([]types.TypeTag, len(strs))
+	for i, str := range strs {
+		typeTag, err := types.ParseAbiTypeTag(str)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *typeTag
+	}
+	return result, nil
+}
+
+// SubstituteTypeParams replaces every generic type parameter T{i} in tag by typeArgs[i]
+func SubstituteTypeParams(tag *types.TypeTag, typeArgs []types.TypeTag) (*types.TypeTag, error) {
+	switch v := tag.Value.(type) {
+	case *types.GenericTag:
+		if int(v.Index) >= len(typeArgs) {
+			return nil, fmt.Errorf("%w: %s with %d type arguments", ErrTypeArgsMismatch, v.String(), len(typeArgs))
+		}
+		return &types.TypeTag{Value: typeArgs[v.Index].Value}, nil
+	case *types.VectorTag:
+		inner, err := SubstituteTypeParams(&v.TypeParam, typeArgs)
+		if err != nil {
+			return nil, err
+		}
+		return &types.TypeTag{Value: &types.VectorTag{TypeParam: *inner}}, nil
+	case *types.ReferenceTag:
+		inner, err := SubstituteTypeParams(&v.TypeParam, typeArgs)
+		if err != nil {
+			return nil, err
+		}
+		return &types.TypeTag{Value: &types.ReferenceTag{Mutable: v.Mutable, TypeParam: *inner}}, nil
+	case *types.StructTag:
+		typeParams := make([]types.TypeTag, len(v.TypeParams))
+		for i := range v.TypeParams {
+			param, err := SubstituteTypeParams(&v.TypeParams[i], typeArgs)
+			if err != nil {
+				return nil, err
+			}
+			typeParams[i] = *param
+		}
+		return &types.TypeTag{Value: &types.StructTag{
+			Address:    v.Address,
+			Module:     v.Module,
+			Name:       v.Name,
+			TypeParams: typeParams,
+		}}, nil
+	default:
+		return tag, nil
+	}
+}
+
+// ResolvedField is a struct field with its instantiated type, Layout is set for struct fields
+// of non native structs
+type ResolvedField struct {
+	Name   string
+	Type   types.TypeTag
+	Layout *StructLayout
+}
+
+// StructLayout is an instantiated struct with the layout of its fields
+type StructLayout struct {
+	Type     *types.StructTag
+	IsNative bool
+	Fields   []ResolvedField
+}
+
+// TypeResolver resolves instantiated function and struct types, module ABIs are cached, it is safe
+// for concurrent use
+type TypeResolver struct {
+	fetch   ModuleAbiFetcher
+	mu      sync.RWMutex
+	modules map[string]*MoveModule
+}
+
+// NewTypeResolver creates a TypeResolver fetching modules with fetch
+func NewTypeResolver(fetch ModuleAbiFetcher) *TypeResolver {
+	return &TypeResolver{
+		fetch:   fetch,
+		modules: make(map[string]*MoveModule),
+	}
+}
+
+// Module returns the ABI of the module, fetching it on first use
+func (r *TypeResolver) Module(moduleAddress types.RoochAddress, moduleName string) (*MoveModule, error) {
+	key := moduleAddress.StringLong() + "::" + moduleName
+	r.mu.RLock()
+	module, ok := r.modules[key]
+	r.mu.RUnlock()
+	if ok {
+		return module, nil
+	}
+
+	module, err := r.fetch(moduleAddress, moduleName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch module %s: %w", key, err)
+	}
+	r.mu.Lock()
+	r.modules[key] = module
+	r.mu.Unlock()
+	return module, nil
+}
+
+// Function returns the ABI of the function
+func (r *TypeResolver) Function(functionId *types.FunctionId) (*MoveFunction, error) {
+	module, err := r.Module(functionId.ModuleId.Address, functionId.ModuleId.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, function := range module.ExposedFunctions {
+		if function.Name == string(functionId.FunctionName) {
+			return function, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s::%s::%s", ErrFunctionNotFound,
+		functionId.ModuleId.Address.String(), functionId.ModuleId.Name, functionId.FunctionName)
+}
+
+// Struct returns the ABI of the struct, the type params of st are ignored
+func (r *TypeResolver) Struct(st *types.StructTag) (*MoveStruct, error) {
+	module, err := r.Module(st.Address, st.Module)
+	if err != nil {
+		return nil, err
+	}
+	for _, moveStruct := range module.Structs {
+		if moveStruct.Name == st.Name {
+			return moveStruct, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s::%s::%s", ErrStructNotFound, st.Address.String(), st.Module, st.Name)
+}
+
+// FunctionParams returns the parameter types of the function instantiated with typeArgs, references
+// such as &signer are kept
+func (r *TypeResolver) FunctionParams(functionId *types.FunctionId, typeArgs []types.TypeTag) ([]types.TypeTag, error) {
+	function, err := r.Function(functionId)
+	if err != nil {
+		return nil, err
+	}
+	if len(function.GenericTypeParams) != len(typeArgs) {
+		return nil, fmt.Errorf("%w: %s expects %d, got %d", ErrTypeArgsMismatch,
+			function.Name, len(function.GenericTypeParams), len(typeArgs))
+	}
+	params, err := function.ParamTypes()
+	if err != nil {
+		return nil, err
+	}
+	return substituteAll(params, typeArgs)
+}
+
+// StructFields returns the fields of the struct instantiated with the type params of st
+func (r *TypeResolver) StructFields(st *types.StructTag) ([]ResolvedField, error) {
+	moveStruct, err := r.Struct(st)
+	if err != nil {
+		return nil, err
+	}
+	if len(moveStruct.GenericTypeParams) != len(st.TypeParams) {
+		return nil, fmt.Errorf("%w: %s expects %d, got %d", ErrTypeArgsMismatch,
+			moveStruct.Name, len(moveStruct.GenericTypeParams), len(st.TypeParams))
+	}
+	fields := make([]ResolvedField, len(moveStruct.Fields))
+	for i, field := range moveStruct.Fields {
+		fieldType, err := field.TypeTag()
+		if err != nil {
+			return nil, fmt.Errorf("invalid type of field %s of %s: %w", field.Name, moveStruct.Name, err)
+		}
+		instantiated, err := SubstituteTypeParams(fieldType, st.TypeParams)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = ResolvedField{Name: field.Name, Type: *instantiated}
+	}
+	return fields, nil
+}
+
+// StructLayout returns the instantiated struct with the layouts of its struct fields, walking the
+// struct definitions, including those inside vectors, down to native structs and primitives
+func (r *TypeResolver) StructLayout(st *types.StructTag) (*StructLayout, error) {
+	moveStruct, err := r.Struct(st)
+	if err != nil {
+		return nil, err
+	}
+	layout := &StructLayout{Type: st, IsNative: moveStruct.IsNative}
+	if moveStruct.IsNative {
+		return layout, nil
+	}
+	fields, err := r.StructFields(st)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fields {
+		if inner := innerStructTag(&fields[i].Type); inner != nil {
+			fields[i].Layout, err = r.StructLayout(inner)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	layout.Fields = fields
+	return layout, nil
+}
+
+// innerStructTag returns the struct of a struct or vector of struct type
+func innerStructTag(tag *types.TypeTag) *types.StructTag {
+	switch v := tag.Value.(type) {
+	case *types.StructTag:
+		return v
+	case *types.VectorTag:
+		return innerStructTag(&v.TypeParam)
+	default:
+		return nil
+	}
+}
+
+func substituteAll(tags []types.TypeTag, typeArgs []types.TypeTag) ([]types.TypeTag, error) {
+	result := make([]types.TypeTag, len(tags))
+	for i := range tags {
+		instantiated, err := SubstituteTypeParams(&tags[i], typeArgs)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *instantiated
+	}
+	return result, nil
+}
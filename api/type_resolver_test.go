@@ -0,0 +1,180 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testModules = map[string]string{
+	"transfer": `{
+		"address": "0x3",
+		"name": "transfer",
+		"friends": [],
+		"exposed_functions": [{
+			"name": "transfer_coin",
+			"visibility": "public",
+			"is_entry": true,
+			"is_view": false,
+			"generic_type_params": [{"constraints": ["key", "store"]}],
+			"params": ["&signer", "address", "u256"],
+			"return": []
+		}, {
+			"name": "balances",
+			"visibility": "public",
+			"is_entry": false,
+			"is_view": true,
+			"generic_type_params": [{"constraints": []}],
+			"params": ["&0x3::coin_store::CoinStore<T0>"],
+			"return": ["vector<0x3::coin::Coin<T0>>"]
+		}],
+		"structs": []
+	}`,
+	"coin": `{
+		"address": "0x3",
+		"name": "coin",
+		"friends": [],
+		"exposed_functions": [],
+		"structs": [{
+			"name": "Coin",
+			"is_native": false,
+			"abilities": ["store"],
+			"generic_type_params": [{"constraints": []}],
+			"fields": [{"name": "value", "type": "u256"}]
+		}]
+	}`,
+	"coin_store": `{
+		"address": "0x3",
+		"name": "coin_store",
+		"friends": [],
+		"exposed_functions": [],
+		"structs": [{
+			"name": "CoinStore",
+			"is_native": false,
+			"abilities": ["key"],
+			"generic_type_params": [{"constraints": []}],
+			"fields": [
+				{"name": "coins", "type": "vector<0x3::coin::Coin<T0>>"},
+				{"name": "owner", "type": "0x2::object::ObjectID"},
+				{"name": "frozen", "type": "bool"}
+			]
+		}]
+	}`,
+	"object": `{
+		"address": "0x2",
+		"name": "object",
+		"friends": [],
+		"exposed_functions": [],
+		"structs": [{
+			"name": "ObjectID",
+			"is_native": true,
+			"abilities": ["copy", "drop", "store"],
+			"generic_type_params": [],
+			"fields": []
+		}]
+	}`,
+}
+
+func testResolver(fetches *atomic.Int32) *TypeResolver {
+	return NewTypeResolver(func(moduleAddress types.RoochAddress, moduleName string) (*MoveModule, error) {
+		fetches.Add(1)
+		abi, ok := testModules[moduleName]
+		if !ok {
+			return nil, errors.New("module not found")
+		}
+		module := &MoveModule{}
+		if err := json.Unmarshal([]byte(abi), module); err != nil {
+			return nil, err
+		}
+		return module, nil
+	})
+}
+
+func TestSubstituteTypeParams(t *testing.T) {
+	rgas, _ := types.ParseTypeTag("0x3::gas_coin::RGas")
+
+	generic, err := types.ParseAbiTypeTag("&mut vector<0x3::coin::Coin<T1>>")
+	assert.NoError(t, err)
+	substituted, err := SubstituteTypeParams(generic, []types.TypeTag{{Value: &types.U8Tag{}}, *rgas})
+	assert.NoError(t, err)
+	assert.Equal(t, "&mut vector<0x3::coin::Coin<0x3::gas_coin::RGas>>", substituted.String())
+
+	_, err = SubstituteTypeParams(generic, []types.TypeTag{*rgas})
+	assert.ErrorIs(t, err, ErrTypeArgsMismatch)
+
+	// Concrete types are left unchanged
+	concrete, _ := types.ParseTypeTag("vector<u64>")
+	substituted, err = SubstituteTypeParams(concrete, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, concrete, substituted)
+}
+
+func TestTypeResolver(t *testing.T) {
+	var fetches atomic.Int32
+	resolver := testResolver(&fetches)
+	rgas, _ := types.ParseTypeTag("0x3::gas_coin::RGas")
+	transferCoin := types.FunctionId{
+		ModuleId:     types.ModuleId{Address: types.AddressThree, Name: "transfer"},
+		FunctionName: "transfer_coin",
+	}
+
+	t.Run("function params", func(t *testing.T) {
+		params, err := resolver.FunctionParams(&transferCoin, []types.TypeTag{*rgas})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"&signer", "address", "u256"}, tagStrings(params))
+
+		balances := transferCoin
+		balances.FunctionName = "balances"
+		params, err = resolver.FunctionParams(&balances, []types.TypeTag{*rgas})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"&0x3::coin_store::CoinStore<0x3::gas_coin::RGas>"}, tagStrings(params))
+
+		_, err = resolver.FunctionParams(&transferCoin, nil)
+		assert.ErrorIs(t, err, ErrTypeArgsMismatch)
+		missing := transferCoin
+		missing.FunctionName = "missing"
+		_, err = resolver.FunctionParams(&missing, nil)
+		assert.ErrorIs(t, err, ErrFunctionNotFound)
+	})
+
+	t.Run("struct fields and layout", func(t *testing.T) {
+		store, _ := types.ParseStructTag("0x3::coin_store::CoinStore<0x3::gas_coin::RGas>")
+		fields, err := resolver.StructFields(store)
+		assert.NoError(t, err)
+		assert.Equal(t, "coins", fields[0].Name)
+		assert.Equal(t, "vector<0x3::coin::Coin<0x3::gas_coin::RGas>>", fields[0].Type.String())
+
+		layout, err := resolver.StructLayout(store)
+		assert.NoError(t, err)
+		assert.Len(t, layout.Fields, 3)
+		coinLayout := layout.Fields[0].Layout
+		assert.Equal(t, "0x3::coin::Coin<0x3::gas_coin::RGas>", coinLayout.Type.String())
+		assert.Equal(t, "u256", coinLayout.Fields[0].Type.String())
+		assert.True(t, layout.Fields[1].Layout.IsNative)
+		assert.Nil(t, layout.Fields[2].Layout)
+
+		unknown, _ := types.ParseStructTag("0x3::coin::Unknown")
+		_, err = resolver.StructFields(unknown)
+		assert.ErrorIs(t, err, ErrStructNotFound)
+	})
+
+	t.Run("modules are fetched once", func(t *testing.T) {
+		before := fetches.Load()
+		_, err := resolver.FunctionParams(&transferCoin, []types.TypeTag{*rgas})
+		assert.NoError(t, err)
+		assert.Equal(t, before, fetches.Load())
+		assert.Equal(t, int32(4), fetches.Load())
+	})
+}
+
+func tagStrings(tags []types.TypeTag) []string {
+	result := make([]string, len(tags))
+	for i := range tags {
+		result[i] = tags[i].String()
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package bitcoin
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// txOverheadWeight is the version and lock time
+	txOverheadWeight = (4 + 4) * 4
+	// segwitMarkerWeight is the segwit marker and flag, witness data
+	segwitMarkerWeight = 2
+	// inputBaseSize is the outpoint, the script sig length and the sequence
+	inputBaseSize = 32 + 4 + 1 + 4
+
+	// p2wpkhWitnessSize is the item count, the signature of at most 72 bytes with sighash and the compressed public key
+	p2wpkhWitnessSize = 1 + 1 + 72 + 1 + 33
+	// p2trKeySpendWitnessSize is the item count and the 64 bytes Schnorr signature with SIGHASH_DEFAULT
+	p2trKeySpendWitnessSize = 1 + 1 + 64
+	// p2pkhScriptSigSize is the signature of at most 72 bytes with sighash and the compressed public key
+	p2pkhScriptSigSize = 1 + 72 + 1 + 33
+	// p2shP2wpkhScriptSigSize is the push of the 22 bytes P2WPKH redeem script
+	p2shP2wpkhScriptSigSize = 1 + 22
+)
+
+// DustLimit is the smallest change output created, change below it goes to the fee
+const DustLimit = 546
+
+// inputWeight is the weight of an input spending pkScript and if its spend carries witness data
+func inputWeight(pkScript []byte) (int64, bool, error) {
+	switch txscript.GetScriptClass(pkScript) {
+	case txscript.WitnessV0PubKeyHashTy:
+		return inputBaseSize*4 + p2wpkhWitnessSize, true, nil
+	case txscript.WitnessV1TaprootTy:
+		return inputBaseSize*4 + p2trKeySpendWitnessSize, true, nil
+	case txscript.PubKeyHashTy:
+		return (inputBaseSize + p2pkhScriptSigSize) * 4, false, nil
+	case txscript.ScriptHashTy:
+		// Only the P2SH-P2WPKH of a single key is spendable with a keypair
+		return (inputBaseSize+p2shP2wpkhScriptSigSize)*4 + p2wpkhWitnessSize, true, nil
+	default:
+		return 0, false, fmt.Errorf("unsupported input script %x", pkScript)
+	}
+}
+
+// outputWeight is the weight of an output paying to pkScript
+func outputWeight(pkScript []byte) int64 {
+	return int64(8+wire.VarIntSerializeSize(uint64(len(pkScript)))+len(pkScript)) * 4
+}
+
+// EstimateVSize estimates the virtual size in vbytes of the signed transaction spending inputs to outputs,
+// signatures are counted at their largest size so the estimate is never below the actual size
+func EstimateVSize(inputs []UTXO, outputs []*wire.TxOut) (int64, error) {
+	weight := int64(txOverheadWeight)
+	weight += int64(wire.VarIntSerializeSize(uint64(len(inputs)))+wire.VarIntSerializeSize(uint64(len(outputs)))) * 4
+	witness, legacy := false, int64(0)
+	for _, input := range inputs {
+		w, hasWitness, err := inputWeight(input.PkScript)
+		if err != nil {
+			return 0, err
+		}
+		weight += w
+		witness = witness || hasWitness
+		if !hasWitness {
+			legacy++
+		}
+	}
+	if witness {
+		// The inputs without witness have an empty witness, its item count
+		weight += segwitMarkerWeight + legacy
+	}
+	for _, output := range outputs {
+		weight += outputWeight(output.PkScript)
+	}
+	return (weight + 3) / 4, nil
+}
+
+// EstimateFee returns the fee in sats of the transaction at feeRate sat/vB, rounded up
+func EstimateFee(inputs []UTXO, outputs []*wire.TxOut, feeRate float64) (int64, error) {
+	vsize, err := EstimateVSize(inputs, outputs)
+	if err != nil {
+		return 0, err
+	}
+	return FeeForVSize(vsize, feeRate), nil
+}
+
+// FeeForVSize returns the fee in sats of vsize vbytes at feeRate sat/vB, rounded up
+func FeeForVSize(vsize int64, feeRate float64) int64 {
+	return int64(math.Ceil(float64(vsize) * feeRate))
+}
@@ -0,0 +1,234 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rooch-network/rooch-go-sdk/address"
+)
+
+var (
+	ErrNoOutputs      = errors.New("transaction has no outputs")
+	ErrInvalidFeeRate = errors.New("fee rate must be positive")
+	ErrDustOutput     = errors.New("output is below the dust limit")
+)
+
+// DefaultSequence signals replace-by-fee, BIP 125
+const DefaultSequence = wire.MaxTxInSequenceNum - 2
+
+// TransactionBuilder builds the PSBT of a Bitcoin transaction, spending the added inputs and the UTXOs
+// selected to fund the outputs and the fee, with the change back to the change address
+type TransactionBuilder struct {
+	network      address.BitcoinNetworkType
+	inputs       []UTXO
+	outputs      []*wire.TxOut
+	changeScript []byte
+	feeRate      float64
+	selector     CoinSelector
+	lockTime     uint32
+}
+
+// PSBT is a built transaction, Fee is the fee it pays and VSize the estimated virtual size once signed.
+// ChangeIndex is the index of the change output, -1 when the change was below the dust limit
+type PSBT struct {
+	Packet      *psbt.Packet
+	Inputs      []UTXO
+	Fee         int64
+	VSize       int64
+	ChangeIndex int
+}
+
+// NewTransactionBuilder creates a builder for the network, at 1 sat/vB with the largest UTXOs selected first
+func NewTransactionBuilder(network address.BitcoinNetworkType) *TransactionBuilder {
+	return &TransactionBuilder{
+		network:  network,
+		feeRate:  1,
+		selector: LargestFirstSelector,
+	}
+}
+
+// AddInput spends the UTXO whatever the outputs are, e.g. to move an inscription
+func (b *TransactionBuilder) AddInput(utxo UTXO) *TransactionBuilder {
+	b.inputs = append(b.inputs, utxo)
+	return b
+}
+
+// AddOutput pays amount sats to the Bitcoin address
+func (b *TransactionBuilder) AddOutput(to string, amount int64) error {
+	pkScript, err := b.addressScript(to)
+	if err != nil {
+		return err
+	}
+	return b.AddOutputScript(pkScript, amount)
+}
+
+// AddOutputScript pays amount sats to pkScript, an OP_RETURN output may have no value
+func (b *TransactionBuilder) AddOutputScript(pkScript []byte, amount int64) error {
+	if amount < DustLimit && !address.IsOpReturnScript(pkScript) {
+		return fmt.Errorf("%w: %d sats", ErrDustOutput, amount)
+	}
+	b.outputs = append(b.outputs, wire.NewTxOut(amount, pkScript))
+	return nil
+}
+
+// SetChangeAddress sets the address of the change, the change goes to the script of the first spent input by default
+func (b *TransactionBuilder) SetChangeAddress(to string) error {
+	pkScript, err := b.addressScript(to)
+	if err != nil {
+		return err
+	}
+	b.changeScript = pkScript
+	return nil
+}
+
+// SetFeeRate sets the fee rate in sat/vB
+func (b *TransactionBuilder) SetFeeRate(satPerVByte float64) *TransactionBuilder {
+	b.feeRate = satPerVByte
+	return b
+}
+
+// SetCoinSelector sets how the UTXOs funding the transaction are selected
+func (b *TransactionBuilder) SetCoinSelector(selector CoinSelector) *TransactionBuilder {
+	b.selector = selector
+	return b
+}
+
+// SetLockTime sets the lock time of the transaction
+func (b *TransactionBuilder) SetLockTime(lockTime uint32) *TransactionBuilder {
+	b.lockTime = lockTime
+	return b
+}
+
+func (b *TransactionBuilder) addressScript(to string) ([]byte, error) {
+	addr, err := address.NewBitcoinAddress(to, b.network)
+	if err != nil {
+		return nil, err
+	}
+	return addr.ScriptPubKey()
+}
+
+// Build selects the UTXOs funding the outputs and the fee among available, and creates the PSBT.
+// The added inputs are always spent, available may be empty when they cover the outputs
+func (b *TransactionBuilder) Build(available []UTXO) (*PSBT, error) {
+	if len(b.outputs) == 0 {
+		return nil, ErrNoOutputs
+	}
+	if b.feeRate <= 0 {
+		return nil, ErrInvalidFeeRate
+	}
+
+	var target, funded int64
+	for _, output := range b.outputs {
+		target += output.Value
+	}
+	for _, input := range b.inputs {
+		funded += input.Value
+	}
+
+	// The fee is estimated with a change output, when there is no change the fee only gets larger.
+	// Until the inputs are selected the change script is unknown, it is counted at the size of a P2TR script
+	changeScript := b.changeScript
+	if changeScript == nil && len(b.inputs) > 0 {
+		changeScript = b.inputs[0].PkScript
+	}
+	estimatedChangeScript := changeScript
+	if estimatedChangeScript == nil {
+		estimatedChangeScript = make([]byte, 34)
+	}
+	withChange := append(append([]*wire.TxOut{}, b.outputs...), wire.NewTxOut(0, estimatedChangeScript))
+	var feeErr error
+	fee := func(selected []UTXO) int64 {
+		value, err := EstimateFee(append(append([]UTXO{}, b.inputs...), selected...), withChange, b.feeRate)
+		if err != nil {
+			feeErr = err
+		}
+		return value
+	}
+
+	inputs := b.inputs
+	if funded < target+fee(nil) {
+		selected, err := b.selector.Select(b.unspent(available), target-funded, fee)
+		if feeErr != nil {
+			return nil, feeErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(append([]UTXO{}, b.inputs...), selected...)
+		for _, input := range selected {
+			funded += input.Value
+		}
+	}
+	if feeErr != nil {
+		return nil, feeErr
+	}
+
+	if changeScript == nil {
+		changeScript = inputs[0].PkScript
+	}
+	outputs := b.outputs
+	changeIndex := -1
+	if change := funded - target - fee(inputs[len(b.inputs):]); change >= DustLimit {
+		outputs = append(append([]*wire.TxOut{}, b.outputs...), wire.NewTxOut(change, changeScript))
+		changeIndex = len(outputs) - 1
+	}
+	vsize, err := EstimateVSize(inputs, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.LockTime = b.lockTime
+	for _, input := range inputs {
+		txIn := wire.NewTxIn(&input.OutPoint, nil, nil)
+		txIn.Sequence = DefaultSequence
+		tx.AddTxIn(txIn)
+	}
+	for _, output := range outputs {
+		tx.AddTxOut(output)
+	}
+	packet, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range inputs {
+		packet.Inputs[i].WitnessUtxo = inputs[i].TxOut()
+	}
+
+	var outputTotal int64
+	for _, output := range outputs {
+		outputTotal += output.Value
+	}
+	return &PSBT{
+		Packet:      packet,
+		Inputs:      inputs,
+		Fee:         funded - outputTotal,
+		VSize:       vsize,
+		ChangeIndex: changeIndex,
+	}, nil
+}
+
+// unspent returns the available UTXOs which are not already added as inputs
+func (b *TransactionBuilder) unspent(available []UTXO) []UTXO {
+	added := make(map[wire.OutPoint]bool, len(b.inputs))
+	for _, input := range b.inputs {
+		added[input.OutPoint] = true
+	}
+	unspent := make([]UTXO, 0, len(available))
+	for _, utxo := range available {
+		if !added[utxo.OutPoint] {
+			unspent = append(unspent, utxo)
+		}
+	}
+	return unspent
+}
+
+// FeeRate is the fee rate in sat/vB of the transaction once signed
+func (p *PSBT) FeeRate() float64 {
+	return float64(p.Fee) / float64(p.VSize)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package bitcoin
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+// testRecipient is a P2WPKH regtest address
+var testRecipient = func() string {
+	addr, _ := btcutil.NewAddressWitnessPubKeyHash(make([]byte, 20), &chaincfg.RegressionNetParams)
+	return addr.EncodeAddress()
+}()
+
+func testKeypair(t *testing.T) (*secp256k1.Secp256k1Keypair, []byte, []byte) {
+	keypair, err := secp256k1.FromSecp256k1SecretKey(bytes.Repeat([]byte{0x1d}, 32), false)
+	assert.NoError(t, err)
+	_, publicKey := btcec.PrivKeyFromBytes(keypair.GetSecretKey())
+	p2wpkh, err := P2WPKHScript(publicKey)
+	assert.NoError(t, err)
+	p2tr, err := P2TRScript(publicKey)
+	assert.NoError(t, err)
+	return keypair, p2wpkh, p2tr
+}
+
+func testUTXO(index byte, value int64, pkScript []byte) UTXO {
+	return UTXO{OutPoint: wire.OutPoint{Hash: chainhash.Hash{index}, Index: uint32(index)}, Value: value, PkScript: pkScript}
+}
+
+func TestCoinSelectors(t *testing.T) {
+	utxos := []UTXO{testUTXO(1, 1000, nil), testUTXO(2, 5000, nil), testUTXO(3, 3000, nil)}
+	fee := func(selected []UTXO) int64 { return int64(100 * len(selected)) }
+	tests := []struct {
+		name     string
+		selector CoinSelector
+		target   int64
+		expected []int64
+	}{
+		{"accumulative", AccumulativeSelector, 5500, []int64{1000, 5000}},
+		{"largest first", LargestFirstSelector, 4000, []int64{5000}},
+		{"largest first two", LargestFirstSelector, 7000, []int64{5000, 3000}},
+		{"smallest first", SmallestFirstSelector, 3500, []int64{1000, 3000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := tt.selector.Select(utxos, tt.target, fee)
+			assert.NoError(t, err)
+			var values []int64
+			for _, utxo := range selected {
+				values = append(values, utxo.Value)
+			}
+			assert.Equal(t, tt.expected, values)
+		})
+	}
+	_, err := LargestFirstSelector.Select(utxos, 9000, fee)
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestEstimateVSize(t *testing.T) {
+	_, p2wpkh, p2tr := testKeypair(t)
+	tests := []struct {
+		name     string
+		inputs   []UTXO
+		outputs  []*wire.TxOut
+		expected int64
+	}{
+		// 10.5 vB overhead, 68 vB input and 31 vB output rounded up
+		{"p2wpkh", []UTXO{testUTXO(1, 0, p2wpkh)}, []*wire.TxOut{wire.NewTxOut(0, p2wpkh)}, 110},
+		// 10.5 vB overhead, 57.5 vB input and 43 vB output
+		{"p2tr", []UTXO{testUTXO(1, 0, p2tr)}, []*wire.TxOut{wire.NewTxOut(0, p2tr)}, 111},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vsize, err := EstimateVSize(tt.inputs, tt.outputs)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, vsize)
+		})
+	}
+	_, err := EstimateVSize([]UTXO{testUTXO(1, 0, []byte{txscript.OP_TRUE})}, nil)
+	assert.Error(t, err)
+}
+
+func TestBuildAndSign(t *testing.T) {
+	keypair, p2wpkh, p2tr := testKeypair(t)
+	available := []UTXO{testUTXO(1, 20000, p2wpkh), testUTXO(2, 50000, p2tr), testUTXO(3, 700, p2wpkh)}
+
+	builder := NewTransactionBuilder(address.BitcoinNetworkRegtest).SetFeeRate(2.5)
+	assert.NoError(t, builder.AddOutput(testRecipient, 60000))
+	assert.ErrorIs(t, builder.AddOutput(testRecipient, 100), ErrDustOutput)
+
+	built, err := builder.Build(available)
+	assert.NoError(t, err)
+	assert.Len(t, built.Inputs, 2)
+	assert.Equal(t, 1, built.ChangeIndex)
+	change := built.Packet.UnsignedTx.TxOut[built.ChangeIndex]
+	assert.Equal(t, built.Inputs[0].PkScript, change.PkScript)
+	assert.Equal(t, int64(70000-60000)-built.Fee, change.Value)
+	assert.Equal(t, FeeForVSize(built.VSize, 2.5), built.Fee)
+
+	signed, err := Sign(built.Packet, keypair)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, signed)
+	tx, err := Finalize(built.Packet)
+	assert.NoError(t, err)
+
+	// Every input must pass the script engine, and the estimate must cover the actual size
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for _, utxo := range built.Inputs {
+		prevOuts.AddPrevOut(utxo.OutPoint, utxo.TxOut())
+	}
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+	for i, utxo := range built.Inputs {
+		engine, err := txscript.NewEngine(utxo.PkScript, tx, i, txscript.StandardVerifyFlags, nil, sigHashes, utxo.Value, prevOuts)
+		assert.NoError(t, err)
+		assert.NoError(t, engine.Execute(), "input %d", i)
+	}
+	weight := int64(tx.SerializeSizeStripped()*3 + tx.SerializeSize())
+	assert.LessOrEqual(t, (weight+3)/4, built.VSize)
+	assert.GreaterOrEqual(t, built.FeeRate(), 2.5)
+
+	encoded, err := EncodeTx(tx)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, encoded)
+
+	t.Run("no change below dust", func(t *testing.T) {
+		builder := NewTransactionBuilder(address.BitcoinNetworkRegtest)
+		assert.NoError(t, builder.AddOutput(testRecipient, 19700))
+		built, err := builder.Build(available[:1])
+		assert.NoError(t, err)
+		assert.Equal(t, -1, built.ChangeIndex)
+		assert.Equal(t, int64(300), built.Fee)
+	})
+	t.Run("insufficient funds", func(t *testing.T) {
+		builder := NewTransactionBuilder(address.BitcoinNetworkRegtest)
+		assert.NoError(t, builder.AddOutput(testRecipient, 80000))
+		_, err := builder.Build(available)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+	t.Run("added inputs are spent", func(t *testing.T) {
+		builder := NewTransactionBuilder(address.BitcoinNetworkRegtest).AddInput(available[2])
+		assert.NoError(t, builder.AddOutput(testRecipient, 10000))
+		built, err := builder.Build(available)
+		assert.NoError(t, err)
+		assert.Equal(t, available[2].OutPoint, built.Inputs[0].OutPoint)
+		assert.Len(t, built.Inputs, 2)
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+)
+
+var ErrMissingWitnessUtxo = errors.New("input has no witness utxo")
+
+// P2WPKHScript returns the P2WPKH script of the compressed public key
+func P2WPKHScript(publicKey *btcec.PublicKey) ([]byte, error) {
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(publicKey.SerializeCompressed())).
+		Script()
+}
+
+// P2TRScript returns the key path only P2TR script of the internal key, tweaked without a script tree as in BIP 86
+func P2TRScript(internalKey *btcec.PublicKey) ([]byte, error) {
+	return txscript.PayToTaprootScript(txscript.ComputeTaprootKeyNoScript(internalKey))
+}
+
+// Sign signs the inputs of the PSBT paying to the P2WPKH or the key path P2TR script of the keypair, with
+// SIGHASH_ALL and SIGHASH_DEFAULT. It returns the number of inputs signed, other inputs are left as is
+func Sign(packet *psbt.Packet, keypair *secp256k1.Secp256k1Keypair) (int, error) {
+	privateKey, publicKey := btcec.PrivKeyFromBytes(keypair.GetSecretKey())
+	p2wpkh, err := P2WPKHScript(publicKey)
+	if err != nil {
+		return 0, err
+	}
+	p2tr, err := P2TRScript(publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	// The taproot sighash commits to every spent output
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, input := range packet.Inputs {
+		if input.WitnessUtxo == nil {
+			return 0, fmt.Errorf("%w: %d", ErrMissingWitnessUtxo, i)
+		}
+		prevOuts.AddPrevOut(packet.UnsignedTx.TxIn[i].PreviousOutPoint, input.WitnessUtxo)
+	}
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, prevOuts)
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return 0, err
+	}
+
+	signed := 0
+	for i := range packet.Inputs {
+		input := &packet.Inputs[i]
+		if input.FinalScriptWitness != nil || input.FinalScriptSig != nil {
+			continue
+		}
+		utxo := input.WitnessUtxo
+		switch {
+		case bytes.Equal(utxo.PkScript, p2wpkh):
+			signature, err := txscript.RawTxInWitnessSignature(packet.UnsignedTx, sigHashes, i, utxo.Value, utxo.PkScript, txscript.SigHashAll, privateKey)
+			if err != nil {
+				return signed, err
+			}
+			if _, err := updater.Sign(i, signature, publicKey.SerializeCompressed(), nil, nil); err != nil {
+				return signed, err
+			}
+		case bytes.Equal(utxo.PkScript, p2tr):
+			signature, err := txscript.RawTxInTaprootSignature(packet.UnsignedTx, sigHashes, i, utxo.Value, utxo.PkScript, nil, txscript.SigHashDefault, privateKey)
+			if err != nil {
+				return signed, err
+			}
+			input.TaprootKeySpendSig = signature
+		default:
+			continue
+		}
+		signed++
+	}
+	return signed, nil
+}
+
+// Finalize finalizes the signed inputs of the PSBT and extracts the transaction to broadcast
+func Finalize(packet *psbt.Packet) (*wire.MsgTx, error) {
+	if err := psbt.MaybeFinalizeAll(packet); err != nil {
+		return nil, err
+	}
+	return psbt.Extract(packet)
+}
+
+// EncodeTx returns the hex of the serialized transaction, as broadcast by btc_broadcastTX
+func EncodeTx(tx *wire.MsgTx) (string, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// UTXO is a spendable output, PkScript is the script the output pays to
+type UTXO struct {
+	OutPoint wire.OutPoint
+	Value    int64
+	PkScript []byte
+}
+
+// NewUTXOFromObject converts a 0x4::utxo::UTXO object of Rooch into a spendable output paying to pkScript,
+// the object does not hold the script, which is the script of the address of its owner
+func NewUTXOFromObject(utxo *types.UTXO, pkScript []byte) UTXO {
+	return UTXO{
+		OutPoint: wire.OutPoint{Hash: chainhash.Hash(utxo.Txid), Index: utxo.Vout},
+		Value:    int64(utxo.Value),
+		PkScript: pkScript,
+	}
+}
+
+// TxOut returns the output the UTXO spends
+func (u *UTXO) TxOut() *wire.TxOut {
+	return wire.NewTxOut(u.Value, u.PkScript)
+}
+
+// CoinSelector selects the UTXOs to spend for a transaction. fee returns the fee of the transaction
+// spending the selected UTXOs, the selected value must cover target plus this fee
+type CoinSelector interface {
+	Select(utxos []UTXO, target int64, fee func(selected []UTXO) int64) ([]UTXO, error)
+}
+
+// CoinSelectorFunc adapts a function into a [CoinSelector]
+type CoinSelectorFunc func(utxos []UTXO, target int64, fee func(selected []UTXO) int64) ([]UTXO, error)
+
+func (f CoinSelectorFunc) Select(utxos []UTXO, target int64, fee func(selected []UTXO) int64) ([]UTXO, error) {
+	return f(utxos, target, fee)
+}
+
+// AccumulativeSelector selects the UTXOs in the given order until they cover the target and the fee
+var AccumulativeSelector CoinSelector = CoinSelectorFunc(accumulate)
+
+// LargestFirstSelector selects the largest UTXOs first, which spends the fewest inputs
+var LargestFirstSelector CoinSelector = CoinSelectorFunc(func(utxos []UTXO, target int64, fee func(selected []UTXO) int64) ([]UTXO, error) {
+	sorted := append([]UTXO{}, utxos...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Value > sorted[j].Value })
+	return accumulate(sorted, target, fee)
+})
+
+// SmallestFirstSelector selects the smallest UTXOs first, which consolidates small outputs
+var SmallestFirstSelector CoinSelector = CoinSelectorFunc(func(utxos []UTXO, target int64, fee func(selected []UTXO) int64) ([]UTXO, error) {
+	sorted := append([]UTXO{}, utxos...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Value < sorted[j].Value })
+	return accumulate(sorted, target, fee)
+})
+
+func accumulate(utxos []UTXO, target int64, fee func(selected []UTXO) int64) ([]UTXO, error) {
+	var selected []UTXO
+	var total int64
+	for _, utxo := range utxos {
+		selected = append(selected, utxo)
+		total += utxo.Value
+		if total >= target+fee(selected) {
+			return selected, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %d sats available for %d sats plus fee %d", ErrInsufficientFunds, total, target, fee(selected))
+}
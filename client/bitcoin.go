@@ -0,0 +1,149 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/bitcoin"
+	client "github.com/rooch-network/rooch-go-sdk/client/types"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+const utxoPageLimit = "100"
+
+// SendBitcoinParams funds, signs and broadcasts the transaction of Builder with the UTXOs of Owner
+type SendBitcoinParams struct {
+	Builder       *bitcoin.TransactionBuilder
+	Signer        *secp256k1.Secp256k1Keypair
+	Owner         *address.BitcoinAddress // Owner of the UTXOs funding the transaction, the address of Signer
+	MaxFeeRate    float64                 // MaxFeeRate rejects the transaction above this fee rate in BTC/kvB, the node default when 0
+	MaxBurnAmount float64                 // MaxBurnAmount rejects unspendable outputs above this value in BTC, the node default when 0
+}
+
+// ListSpendableUTXOs lists the UTXOs of the Bitcoin address from btc_queryUTXOs. The UTXOs holding
+// sealed objects, such as inscriptions, are left out so they are not spent as fee by mistake
+func (c *RoochClient) ListSpendableUTXOs(owner *address.BitcoinAddress) ([]bitcoin.UTXO, error) {
+	pkScript, err := owner.ScriptPubKey()
+	if err != nil {
+		return nil, err
+	}
+	var utxos []bitcoin.UTXO
+	var cursor interface{}
+	for {
+		var page client.PaginatedResponse[client.IndexerObjectStateView]
+		err := c.transport.Request("btc_queryUTXOs", []interface{}{
+			map[string]interface{}{"owner": owner.String()},
+			cursor,
+			utxoPageLimit,
+			false,
+		}, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, state := range page.Data {
+			value, err := utils.ParseHex(state.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of utxo %s: %w", state.ID, err)
+			}
+			utxo := &types.UTXO{}
+			if err := bcs.Deserialize(utxo, value); err != nil {
+				return nil, fmt.Errorf("failed to decode utxo %s: %w", state.ID, err)
+			}
+			if len(utxo.Seals) > 0 {
+				continue
+			}
+			utxos = append(utxos, bitcoin.NewUTXOFromObject(utxo, pkScript))
+		}
+		if !page.HasNextPage || page.NextCursor == nil {
+			return utxos, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// GetUTXOs fetches the UTXO objects of the outpoints by their object IDs, derived from the outpoints without
+// querying the indexer. The entity of an outpoint which is spent or not synced yet is nil
+func (c *RoochClient) GetUTXOs(outpoints []types.OutPoint, anchor *StateAnchor) ([]*types.ObjectEntity[types.UTXO], error) {
+	objectIDs := make([]types.ObjectID, len(outpoints))
+	for i := range outpoints {
+		objectID, err := outpoints[i].ObjectID()
+		if err != nil {
+			return nil, err
+		}
+		objectIDs[i] = objectID
+	}
+	return GetObjectEntities[types.UTXO](c, GetObjectStatesParams{ObjectIDs: joinObjectIDs(objectIDs), Anchor: anchor})
+}
+
+// GetInscriptions fetches the Inscription objects of the inscription IDs by their object IDs, derived from
+// the inscription IDs without querying the indexer. The entity of an unknown inscription is nil
+func (c *RoochClient) GetInscriptions(ids []types.InscriptionID, anchor *StateAnchor) ([]*types.ObjectEntity[types.Inscription], error) {
+	objectIDs := make([]types.ObjectID, len(ids))
+	for i := range ids {
+		objectID, err := ids[i].ObjectID()
+		if err != nil {
+			return nil, err
+		}
+		objectIDs[i] = objectID
+	}
+	return GetObjectEntities[types.Inscription](c, GetObjectStatesParams{ObjectIDs: joinObjectIDs(objectIDs), Anchor: anchor})
+}
+
+// joinObjectIDs joins the object IDs as the comma separated list of rooch_getObjectStates
+func joinObjectIDs(objectIDs []types.ObjectID) string {
+	ids := make([]string, len(objectIDs))
+	for i := range objectIDs {
+		ids[i] = objectIDs[i].String()
+	}
+	return strings.Join(ids, ",")
+}
+
+// BroadcastTX broadcasts the hex of a signed Bitcoin transaction and returns its txid
+func (c *RoochClient) BroadcastTX(params BroadcastTXParams) (string, error) {
+	var maxFeeRate, maxBurnAmount interface{}
+	if params.MaxFeeRate > 0 {
+		maxFeeRate = params.MaxFeeRate
+	}
+	if params.MaxBurnAmount > 0 {
+		maxBurnAmount = params.MaxBurnAmount
+	}
+	var txid string
+	err := c.transport.Request("btc_broadcastTX", []interface{}{
+		params.Hex,
+		maxFeeRate,
+		maxBurnAmount,
+	}, &txid)
+	return txid, err
+}
+
+// SendBitcoin builds the transaction with the spendable UTXOs of the owner, signs and broadcasts it, and returns its txid
+func (c *RoochClient) SendBitcoin(params SendBitcoinParams) (string, error) {
+	utxos, err := c.ListSpendableUTXOs(params.Owner)
+	if err != nil {
+		return "", err
+	}
+	built, err := params.Builder.Build(utxos)
+	if err != nil {
+		return "", err
+	}
+	if _, err := bitcoin.Sign(built.Packet, params.Signer); err != nil {
+		return "", err
+	}
+	tx, err := bitcoin.Finalize(built.Packet)
+	if err != nil {
+		return "", err
+	}
+	encoded, err := bitcoin.EncodeTx(tx)
+	if err != nil {
+		return "", err
+	}
+	return c.BroadcastTX(BroadcastTXParams{
+		Hex:           encoded,
+		MaxFeeRate:    params.MaxFeeRate,
+		MaxBurnAmount: params.MaxBurnAmount,
+	})
+}
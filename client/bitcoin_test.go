@@ -0,0 +1,123 @@
+package client
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testUTXOStateJSON is the indexer JSON view of a UTXO object
+func testUTXOStateJSON(t *testing.T, utxo *types.UTXO) string {
+	value, err := bcs.Serialize(utxo)
+	assert.NoError(t, err)
+	outpoint := utxo.OutPoint()
+	id, err := outpoint.ObjectID()
+	assert.NoError(t, err)
+	return `{
+    "id": "` + id.String() + `",
+    "owner": "0x` + strings.Repeat("0a", 32) + `",
+    "flag": 0,
+    "size": "0",
+    "created_at": "10",
+    "updated_at": "20",
+    "object_type": "0x4::utxo::UTXO",
+    "state_index": "0",
+    "tx_order": "1",
+    "value": "0x` + hex.EncodeToString(value) + `"
+  }`
+}
+
+func TestListSpendableUTXOs(t *testing.T) {
+	owner, err := address.NewBitcoinAddress("bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", address.BitcoinNetworkBitcoin)
+	assert.NoError(t, err)
+	pkScript, err := owner.ScriptPubKey()
+	assert.NoError(t, err)
+
+	spendable := &types.UTXO{Txid: types.RoochAddress{0x01}, Vout: 1, Value: 1000}
+	sealed := &types.UTXO{Txid: types.RoochAddress{0x02}, Value: 546, Seals: []types.SealEntry{{
+		Key:   "0x4::ord::Inscription",
+		Value: []types.ObjectID{types.NewObjectID([]types.RoochAddress{types.RoochAddress{0x03}})},
+	}}}
+	next := &types.UTXO{Txid: types.RoochAddress{0x04}, Vout: 2, Value: 2000}
+	cursor := `{"tx_order": "1", "state_index": "1"}`
+	transport := &testTransport{pages: map[string][]string{"btc_queryUTXOs": {
+		`{"data": [` + testUTXOStateJSON(t, spendable) + `, ` + testUTXOStateJSON(t, sealed) + `], "next_cursor": ` + cursor + `, "has_next_page": true}`,
+		`{"data": [` + testUTXOStateJSON(t, next) + `], "next_cursor": null, "has_next_page": false}`,
+	}}}
+	client := NewRoochClient(RoochClientOptions{Transport: transport})
+
+	utxos, err := client.ListSpendableUTXOs(owner)
+	assert.NoError(t, err)
+	// The UTXO holding an inscription is left out
+	assert.Len(t, utxos, 2)
+	assert.Equal(t, [32]byte(spendable.Txid), [32]byte(utxos[0].OutPoint.Hash))
+	assert.Equal(t, uint32(1), utxos[0].OutPoint.Index)
+	assert.Equal(t, int64(1000), utxos[0].Value)
+	assert.Equal(t, pkScript, utxos[0].PkScript)
+	assert.Equal(t, [32]byte(next.Txid), [32]byte(utxos[1].OutPoint.Hash))
+	assert.Equal(t, int64(2000), utxos[1].Value)
+
+	// The second page continues from the cursor of the first one
+	assert.Equal(t, []string{"btc_queryUTXOs", "btc_queryUTXOs"}, transport.methods)
+	filter := map[string]interface{}{"owner": owner.String()}
+	assert.Equal(t, []interface{}{filter, nil, "100", false}, transport.params[0])
+	assert.Equal(t, filter, transport.params[1][0])
+	assert.Equal(t, map[string]interface{}{"tx_order": "1", "state_index": "1"}, transport.params[1][1])
+
+	t.Run("invalid value", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{"btc_queryUTXOs": `{"data": [{"id": "0x01", "value": "0x01"}], "has_next_page": false}`}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		_, err := client.ListSpendableUTXOs(owner)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetUTXOsAndInscriptions(t *testing.T) {
+	outpoint, err := types.ParseOutPoint("4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b:1")
+	assert.NoError(t, err)
+	first := types.OutPoint{Txid: outpoint.Txid}
+
+	t.Run("utxos", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{"rooch_getObjectStates": `[null, null]`}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		utxos, err := client.GetUTXOs([]types.OutPoint{outpoint, first}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []*types.ObjectEntity[types.UTXO]{nil, nil}, utxos)
+		// The object IDs are derived from the outpoints, see TestOutPoint
+		assert.Equal(t, "0xcc4ca9e359ef9dae42965b83f44a4a0bdb4d81e3ef01efe0aa405f407663e732,"+
+			"0xdf947fb47fa9aeb8448d282ad30172b5c82622bc5b17a5e80ae10a99e902de81", transport.params[0][0])
+	})
+
+	t.Run("inscriptions", func(t *testing.T) {
+		id, err := types.ParseInscriptionID("6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799i7")
+		assert.NoError(t, err)
+		transport := &testTransport{results: map[string]string{"rooch_getObjectStates": `[null]`}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		inscriptions, err := client.GetInscriptions([]types.InscriptionID{id}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []*types.ObjectEntity[types.Inscription]{nil}, inscriptions)
+		// See TestInscriptionID
+		assert.Equal(t, "0x7b1073e2e4f27047ad380dc5e4c4bc532b0375d0d88b0490e7bab77aa6b9028d", transport.params[0][0])
+	})
+}
+
+func TestBroadcastTX(t *testing.T) {
+	txid := strings.Repeat("ab", 32)
+	transport := &testTransport{results: map[string]string{"btc_broadcastTX": `"` + txid + `"`}}
+	client := NewRoochClient(RoochClientOptions{Transport: transport})
+
+	result, err := client.BroadcastTX(BroadcastTXParams{Hex: "0200"})
+	assert.NoError(t, err)
+	assert.Equal(t, txid, result)
+	// The node defaults apply to the limits which are not set
+	assert.Equal(t, []interface{}{"0200", nil, nil}, transport.params[0])
+
+	_, err = client.BroadcastTX(BroadcastTXParams{Hex: "0200", MaxFeeRate: 0.1, MaxBurnAmount: 0.5})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"0200", 0.1, 0.5}, transport.params[1])
+}
@@ -3,9 +3,11 @@ package client
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"github.com/rooch-network/rooch-go-sdk/api"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
 	client "github.com/rooch-network/rooch-go-sdk/client/types"
-	"github.com/rooch-network/rooch-go-sdk/crypto"
+	//"github.com/rooch-network/rooch-go-sdk/crypto"
 	"github.com/rooch-network/rooch-go-sdk/types"
 	"github.com/rooch-network/rooch-go-sdk/utils"
 
@@ -73,25 +75,44 @@ func (c *RoochClient) GetChainId() (uint64, error) {
 }
 
 func (c *RoochClient) ExecuteViewFunction(input api.CallFunctionArgs) (*client.AnnotatedFunctionResultView, error) {
-	callFunction := api.CallFunctionArgs(input)
+	return c.ExecuteViewFunctionAt(input, nil)
+}
 
-	var result client.AnnotatedFunctionResultView
-	err := c.transport.Request("rooch_executeViewFunction", []interface{}{
+// ExecuteViewFunctionAt executes the view function on the historical state of the anchor, the
+// latest state when anchor is nil
+func (c *RoochClient) ExecuteViewFunctionAt(input api.CallFunctionArgs, anchor *StateAnchor) (*client.AnnotatedFunctionResultView, error) {
+	callFunction := api.NewCallFunction(input)
+
+	params := []interface{}{
 		map[string]interface{}{
 			"function_id": callFunction.FunctionId(),
 			"args":        callFunction.EncodeArgs(),
 			"ty_args":     callFunction.TypeArgs,
 		},
-	}, &result)
+	}
+	if anchor != nil {
+		stateOption, err := c.anchoredStateOption(nil, anchor)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, stateOption)
+	}
+
+	var result client.AnnotatedFunctionResultView
+	err := c.transport.Request("rooch_executeViewFunction", params, &result)
 
 	return &result, err
 }
 
 func (c *RoochClient) GetStates(params GetStatesParams) ([]types.ObjectStateView, error) {
+	stateOption, err := c.anchoredStateOption(params.StateOption, params.Anchor)
+	if err != nil {
+		return nil, err
+	}
 	var result []types.ObjectStateView
-	err := c.transport.Request("rooch_getStates", []interface{}{
+	err = c.transport.Request("rooch_getStates", []interface{}{
 		params.AccessPath,
-		params.StateOption,
+		stateOption,
 	}, &result)
 
 	if result == nil {
@@ -100,19 +121,123 @@ func (c *RoochClient) GetStates(params GetStatesParams) ([]types.ObjectStateView
 	return result, err
 }
 
-func (c *RoochClient) ListStates(params ListStatesParams) (*types.PaginatedStateKVViews, error) {
-	var result types.PaginatedStateKVViews
-	err := c.transport.Request("rooch_listStates", []interface{}{
+// GetStatesWithProof fetches the object states of the access path with their proofs under the state
+// root of the state option, or the latest state root when it is not set. The proofs are not verified
+func (c *RoochClient) GetStatesWithProof(params GetStatesParams) ([]types.ObjectStateWithProof, error) {
+	stateOption, err := c.anchoredStateOption(params.StateOption, params.Anchor)
+	if err != nil {
+		return nil, err
+	}
+	var result []types.ObjectStateWithProof
+	err = c.transport.Request("rooch_getStatesWithProof", []interface{}{
+		params.AccessPath,
+		stateOption,
+	}, &result)
+	return result, err
+}
+
+// GetVerifiedStates fetches the object states of the access path under trustedRoot and verifies their
+// proofs, a nil state in the result is an object proven not to exist
+func (c *RoochClient) GetVerifiedStates(accessPath string, trustedRoot types.H256) ([]*types.ObjectStateView, error) {
+	results, err := c.GetStatesWithProof(GetStatesParams{
+		AccessPath: accessPath,
+		Anchor:     AtStateRoot(trustedRoot),
+	})
+	if err != nil {
+		return nil, err
+	}
+	states := make([]*types.ObjectStateView, len(results))
+	for i := range results {
+		if err := results[i].Verify(trustedRoot); err != nil {
+			return nil, err
+		}
+		states[i] = results[i].State
+	}
+	return states, nil
+}
+
+func (c *RoochClient) ListStates(params ListStatesParams) (*client.PaginatedStateKVViews, error) {
+	stateOption, err := c.anchoredStateOption(params.StateOption, params.Anchor)
+	if err != nil {
+		return nil, err
+	}
+	var result client.PaginatedStateKVViews
+	err = c.transport.Request("rooch_listStates", []interface{}{
 		params.AccessPath,
 		params.Cursor,
 		params.Limit,
-		params.StateOption,
+		stateOption,
 	}, &result)
 	return &result, err
 }
 
-func (c *RoochClient) GetModuleAbi(params GetModuleABIParams) (*types.ModuleABIView, error) {
-	var result types.ModuleABIView
+// GetObjectStates fetches the states of the comma separated object IDs, the state of a missing object is nil
+func (c *RoochClient) GetObjectStates(params GetObjectStatesParams) ([]*types.ObjectStateView, error) {
+	stateOption, err := c.anchoredStateOption(params.StateOption, params.Anchor)
+	if err != nil {
+		return nil, err
+	}
+	var result []*types.ObjectStateView
+	err = c.transport.Request("rooch_getObjectStates", []interface{}{
+		params.ObjectIDs,
+		stateOption,
+	}, &result)
+	return result, err
+}
+
+// GetObjectEntities fetches the objects with their value decoded into the typed Move struct view T,
+// e.g. [types.CoinStore], the entity of a missing object is nil
+func GetObjectEntities[T any](c *RoochClient, params GetObjectStatesParams) ([]*types.ObjectEntity[T], error) {
+	states, err := c.GetObjectStates(params)
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]*types.ObjectEntity[T], len(states))
+	for i, state := range states {
+		if state == nil {
+			continue
+		}
+		entities[i], err = types.NewObjectEntity[T](&state.ObjectState)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// GetFieldStates fetches the states of the fields of an object
+func (c *RoochClient) GetFieldStates(params GetFieldStatesParams) ([]types.ObjectStateView, error) {
+	stateOption, err := c.anchoredStateOption(params.StateOption, params.Anchor)
+	if err != nil {
+		return nil, err
+	}
+	var result []types.ObjectStateView
+	err = c.transport.Request("rooch_getFieldStates", []interface{}{
+		params.ObjectID,
+		params.FieldKey,
+		stateOption,
+	}, &result)
+	return result, err
+}
+
+// ListFieldStates lists the states of the fields of an object
+func (c *RoochClient) ListFieldStates(params ListFieldStatesParams) (*client.PaginatedStateKVViews, error) {
+	stateOption, err := c.anchoredStateOption(params.StateOption, params.Anchor)
+	if err != nil {
+		return nil, err
+	}
+	var result client.PaginatedStateKVViews
+	err = c.transport.Request("rooch_listFieldStates", []interface{}{
+		params.ObjectID,
+		params.Cursor,
+		params.Limit,
+		stateOption,
+	}, &result)
+	return &result, err
+}
+
+func (c *RoochClient) GetModuleAbi(params GetModuleABIParams) (*client.ModuleABIView, error) {
+	var result client.ModuleABIView
 	err := c.transport.Request("rooch_getModuleABI", []interface{}{
 		params.ModuleAddr,
 		params.ModuleName,
@@ -120,8 +245,8 @@ func (c *RoochClient) GetModuleAbi(params GetModuleABIParams) (*types.ModuleABIV
 	return &result, err
 }
 
-func (c *RoochClient) GetEvents(params GetEventsByEventHandleParams) (*types.PaginatedEventViews, error) {
-	var result types.PaginatedEventViews
+func (c *RoochClient) GetEvents(params GetEventsByEventHandleParams) (*client.PaginatedEventViews, error) {
+	var result client.PaginatedEventViews
 	err := c.transport.Request("rooch_getEventsByEventHandle", []interface{}{
 		params.EventHandleType,
 		params.Cursor,
@@ -132,8 +257,8 @@ func (c *RoochClient) GetEvents(params GetEventsByEventHandleParams) (*types.Pag
 	return &result, err
 }
 
-func (c *RoochClient) QueryEvents(params QueryEventsParams) (*types.PaginatedIndexerEventViews, error) {
-	var result types.PaginatedIndexerEventViews
+func (c *RoochClient) QueryEvents(params QueryEventsParams) (*client.PaginatedIndexerEventViews, error) {
+	var result client.PaginatedIndexerEventViews
 	err := c.transport.Request("rooch_queryEvents", []interface{}{
 		params.Filter,
 		params.Cursor,
@@ -143,8 +268,8 @@ func (c *RoochClient) QueryEvents(params QueryEventsParams) (*types.PaginatedInd
 	return &result, err
 }
 
-func (c *RoochClient) QueryInscriptions(params QueryInscriptionsParams) (*types.PaginatedInscriptionStateViews, error) {
-	var result types.PaginatedInscriptionStateViews
+func (c *RoochClient) QueryInscriptions(params QueryInscriptionsParams) (*client.PaginatedInscriptionStateViews, error) {
+	var result client.PaginatedInscriptionStateViews
 	err := c.transport.Request("btc_queryInscriptions", []interface{}{
 		params.Filter,
 		params.Cursor,
@@ -154,93 +279,160 @@ func (c *RoochClient) QueryInscriptions(params QueryInscriptionsParams) (*types.
 	return &result, err
 }
 
-func (c *RoochClient) Transfer(params TransferParams) (*types.ExecuteTransactionResponseView, error) {
-	tx := transactions.NewTransaction()
-	tx.CallFunction(api.CallFunctionArgs{
-		Target:   "0x3::transfer::transfer_coin",
-		Args:     []interface{}{params.Recipient, params.Amount},
-		TypeArgs: []string{types.NormalizeTypeArgsToStr(params.CoinType)},
-	})
-
-	return c.SignAndExecuteTransaction(struct {
-		Transaction interface{}
-		Signer      crypto.Signer
-		Option      *struct{ WithOutput bool }
-	}{
-		Transaction: tx,
-		Signer:      params.Signer,
-	})
-}
-
-func (c *RoochClient) TransferObject(params TransferObjectParams) (*types.ExecuteTransactionResponseView, error) {
-	tx := transactions.NewTransaction()
-	tx.CallFunction(api.CallFunctionArgs{
-		Target:   "0x3::transfer::transfer_object",
-		Args:     []interface{}{params.Recipient, params.ObjectID},
-		TypeArgs: []string{types.NormalizeTypeArgsToStr(params.ObjectType)},
-	})
-
-	return c.SignAndExecuteTransaction(struct {
-		Transaction interface{}
-		Signer      crypto.Signer
-		Option      *struct{ WithOutput bool }
-	}{
-		Transaction: tx,
-		Signer:      params.Signer,
-	})
-}
-
+// TODO: Transfer, TransferObject, CreateSession and RemoveSession need a transaction builder and
+// SignAndExecuteTransaction, which the client does not provide yet
+
+//func (c *RoochClient) Transfer(params TransferParams) (*types.ExecuteTransactionResponseView, error) {
+//	tx := transactions.NewTransaction()
+//	tx.CallFunction(api.CallFunctionArgs{
+//		Target:   "0x3::transfer::transfer_coin",
+//		Args:     []interface{}{params.Recipient, params.Amount},
+//		TypeArgs: []string{types.NormalizeTypeArgsToStr(params.CoinType)},
+//	})
+//
+//	return c.SignAndExecuteTransaction(struct {
+//		Transaction interface{}
+//		Signer      crypto.Signer
+//		Option      *struct{ WithOutput bool }
+//	}{
+//		Transaction: tx,
+//		Signer:      params.Signer,
+//	})
+//}
+//
+//func (c *RoochClient) TransferObject(params TransferObjectParams) (*types.ExecuteTransactionResponseView, error) {
+//	tx := transactions.NewTransaction()
+//	tx.CallFunction(api.CallFunctionArgs{
+//		Target:   "0x3::transfer::transfer_object",
+//		Args:     []interface{}{params.Recipient, params.ObjectID},
+//		TypeArgs: []string{types.NormalizeTypeArgsToStr(params.ObjectType)},
+//	})
+//
+//	return c.SignAndExecuteTransaction(struct {
+//		Transaction interface{}
+//		Signer      crypto.Signer
+//		Option      *struct{ WithOutput bool }
+//	}{
+//		Transaction: tx,
+//		Signer:      params.Signer,
+//	})
+//}
+
+// ResolveBTCAddress resolves the Bitcoin address mapped to a Rooch address with
+// 0x3::address_mapping::resolve_bitcoin, it returns nil when the Rooch address has no mapping
 func (c *RoochClient) ResolveBTCAddress(roochAddr string, network address.BitcoinNetworkType) (*address.BitcoinAddress, error) {
+	arg, err := api.ArgAddress(roochAddr)
+	if err != nil {
+		return nil, err
+	}
 	result, err := c.ExecuteViewFunction(api.CallFunctionArgs{
 		Target: "0x3::address_mapping::resolve_bitcoin",
-		Args:   []interface{}{roochAddr},
+		Args:   []api.Args{*arg},
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	if result.VMStatus != "Executed" || len(result.ReturnValues) == 0 {
-		return nil, nil
+	// The return value is the BCS encoded Option<BitcoinAddress>, the address is a struct of its bytes
+	value, err := optionReturnValue(result)
+	if err != nil || value == nil {
+		return nil, err
 	}
-
-	// Extract address bytes from the result
-	value := result.ReturnValues[0].DecodedValue.(map[string]interface{})
-	addressBytes := value["value"].(map[string]interface{})["vec"].([]interface{})[0].(map[string]interface{})["value"].(map[string]interface{})["bytes"].(string)
-
-	return address.NewBitcoinAddress(addressBytes, network)
+	des := bcs.NewDeserializer(value)
+	addressBytes := des.ReadBytes()
+	if err := des.Error(); err != nil {
+		return nil, err
+	}
+	return address.NewBitcoinAddress(hex.EncodeToString(addressBytes), network)
 }
 
-func (c *RoochClient) CreateSession(args session.CreateSessionArgs, signer crypto.Signer) (*session.Session, error) {
-	return session.Create(session.CreateParams{
-		Args:   args,
-		Client: c,
-		Signer: signer,
+// ResolveAddress resolves the Rooch address mapped to a chain address with 0x3::address_mapping::resolve,
+// it returns nil when the chain address has no mapping yet
+func (c *RoochClient) ResolveAddress(multiChainAddress *address.MultiChainAddress) (*address.RoochAddress, error) {
+	arg, err := api.ArgMultiChainAddress(multiChainAddress)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.ExecuteViewFunction(api.CallFunctionArgs{
+		Target: "0x3::address_mapping::resolve",
+		Args:   []api.Args{*arg},
 	})
-}
-
-func (c *RoochClient) RemoveSession(authKey string, signer crypto.Signer) (bool, error) {
-	authKeyBytes, err := hex.DecodeString(authKey)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	tx := transactions.NewTransaction()
-	tx.CallFunction(api.CallFunctionArgs{
-		Target: "0x3::session_key::remove_session_key_entry",
-		Args:   []interface{}{authKeyBytes},
-	})
+	// The return value is the BCS encoded Option<address>
+	value, err := optionReturnValue(result)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return address.NewRoochAddressFromBytes(value)
+}
 
-	resp, err := c.SignAndExecuteTransaction(struct {
-		Transaction interface{}
-		Signer      crypto.Signer
-		Option      *struct{ WithOutput bool }
-	}{
-		Transaction: tx,
-		Signer:      signer,
-	})
+// optionReturnValue returns the BCS bytes of the value of the Option returned first by a view
+// function, nil when the function did not execute, returned nothing or returned none
+func optionReturnValue(result *client.AnnotatedFunctionResultView) ([]byte, error) {
+	if result == nil || !result.VMStatus.IsExecuted() || result.ReturnValues == nil || len(*result.ReturnValues) == 0 {
+		return nil, nil
+	}
+	value, err := utils.HexToBytes((*result.ReturnValues)[0].Value.Value)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
+	if len(value) == 0 || value[0] == 0 {
+		return nil, nil
+	}
+	return value[1:], nil
+}
 
-	return resp.ExecutionInfo.Status.Type == "executed", nil
+// ReverseResolveAddress returns the typed chain address mapped to a Rooch address, only Bitcoin
+// mappings are recorded on chain, network is used to render the Bitcoin address
+func (c *RoochClient) ReverseResolveAddress(roochAddr string, multiChainID uint64, network address.BitcoinNetworkType) (address.ChainAddress, error) {
+	switch multiChainID {
+	case address.MultiChainIDRooch:
+		return address.NewRoochAddress(roochAddr)
+	case address.MultiChainIDBitcoin:
+		bitcoinAddress, err := c.ResolveBTCAddress(roochAddr, network)
+		if err != nil || bitcoinAddress == nil {
+			return nil, err
+		}
+		return bitcoinAddress, nil
+	default:
+		return nil, fmt.Errorf("reverse resolution is not supported for multi chain id %d", multiChainID)
+	}
 }
+
+//func (c *RoochClient) CreateSession(args session.CreateSessionArgs, signer crypto.Signer) (*session.Session, error) {
+//	return session.Create(session.CreateParams{
+//		Args:   args,
+//		Client: c,
+//		Signer: signer,
+//	})
+//}
+//
+//func (c *RoochClient) RemoveSession(authKey string, signer crypto.Signer) (bool, error) {
+//	authKeyBytes, err := hex.DecodeString(authKey)
+//	if err != nil {
+//		return false, err
+//	}
+//
+//	tx := transactions.NewTransaction()
+//	tx.CallFunction(api.CallFunctionArgs{
+//		Target: "0x3::session_key::remove_session_key_entry",
+//		Args:   []interface{}{authKeyBytes},
+//	})
+//
+//	resp, err := c.SignAndExecuteTransaction(struct {
+//		Transaction interface{}
+//		Signer      crypto.Signer
+//		Option      *struct{ WithOutput bool }
+//	}{
+//		Transaction: tx,
+//		Signer:      signer,
+//	})
+//	if err != nil {
+//		return false, err
+//	}
+//
+//	return resp.ExecutionInfo.Status.Type == "executed", nil
+//}
@@ -0,0 +1,271 @@
+package client
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testViewResult is the JSON result of a view function returning the BCS value of the type
+func testViewResult(typeTag string, value string) string {
+	return `{
+  "vm_status": "Executed",
+  "return_values": [{
+    "value": {"type_tag": "` + typeTag + `", "value": "` + value + `"},
+    "decoded_value": null
+  }]
+}`
+}
+
+// testWitnessAddress is bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu wrapped as a witness address
+const testWitnessAddress = "0200c0cebcd6c3d3ca8c75dc5ec62ebe55330ef910e2"
+
+func TestResolveBTCAddress(t *testing.T) {
+	roochAddr := "0x" + strings.Repeat("0a", 32)
+
+	t.Run("mapped", func(t *testing.T) {
+		// Some(BitcoinAddress { bytes }), the bytes are prefixed by their length
+		transport := &testTransport{results: map[string]string{
+			"rooch_executeViewFunction": testViewResult("0x1::option::Option<0x3::bitcoin_address::BitcoinAddress>", "0x0116"+testWitnessAddress),
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		bitcoinAddress, err := client.ResolveBTCAddress(roochAddr, address.BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", bitcoinAddress.String())
+
+		assert.Equal(t, []string{"rooch_executeViewFunction"}, transport.methods)
+		call := transport.params[0][0].(map[string]interface{})
+		assert.Equal(t, "0x"+strings.Repeat("0", 63)+"3::address_mapping::resolve_bitcoin", call["function_id"])
+		assert.Equal(t, []string{roochAddr}, call["args"])
+	})
+
+	t.Run("no mapping", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{
+			"rooch_executeViewFunction": testViewResult("0x1::option::Option<0x3::bitcoin_address::BitcoinAddress>", "0x00"),
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		bitcoinAddress, err := client.ResolveBTCAddress(roochAddr, address.BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Nil(t, bitcoinAddress)
+	})
+
+	t.Run("no return values", func(t *testing.T) {
+		for _, result := range []string{`{"vm_status": "Executed"}`, `{"vm_status": "Executed", "return_values": []}`} {
+			transport := &testTransport{results: map[string]string{"rooch_executeViewFunction": result}}
+			client := NewRoochClient(RoochClientOptions{Transport: transport})
+			bitcoinAddress, err := client.ResolveBTCAddress(roochAddr, address.BitcoinNetworkBitcoin)
+			assert.NoError(t, err)
+			assert.Nil(t, bitcoinAddress)
+		}
+	})
+
+	t.Run("truncated value", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{
+			"rooch_executeViewFunction": testViewResult("0x1::option::Option<0x3::bitcoin_address::BitcoinAddress>", "0x011602"),
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		_, err := client.ResolveBTCAddress(roochAddr, address.BitcoinNetworkBitcoin)
+		assert.Error(t, err)
+	})
+}
+
+func TestResolveAddress(t *testing.T) {
+	rawAddress, err := hex.DecodeString(testWitnessAddress)
+	assert.NoError(t, err)
+	multiChainAddress, err := address.NewMultiChainAddress(address.MultiChainIDBitcoin, rawAddress)
+	assert.NoError(t, err)
+
+	t.Run("mapped", func(t *testing.T) {
+		roochAddr := strings.Repeat("0b", 32)
+		transport := &testTransport{results: map[string]string{
+			"rooch_executeViewFunction": testViewResult("0x1::option::Option<address>", "0x01"+roochAddr),
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		resolved, err := client.ResolveAddress(multiChainAddress)
+		assert.NoError(t, err)
+		assert.Equal(t, "0x"+roochAddr, resolved.StringLong())
+
+		call := transport.params[0][0].(map[string]interface{})
+		assert.Equal(t, "0x"+strings.Repeat("0", 63)+"3::address_mapping::resolve", call["function_id"])
+		assert.Len(t, call["args"], 1)
+	})
+
+	t.Run("no mapping", func(t *testing.T) {
+		for _, result := range []string{
+			testViewResult("0x1::option::Option<address>", "0x00"),
+			`{"vm_status": "Executed"}`,
+			`{"vm_status": "Executed", "return_values": []}`,
+		} {
+			transport := &testTransport{results: map[string]string{"rooch_executeViewFunction": result}}
+			client := NewRoochClient(RoochClientOptions{Transport: transport})
+			resolved, err := client.ResolveAddress(multiChainAddress)
+			assert.NoError(t, err)
+			assert.Nil(t, resolved)
+		}
+	})
+}
+
+func TestReverseResolveAddress(t *testing.T) {
+	roochAddr := "0x" + strings.Repeat("0a", 32)
+
+	t.Run("rooch", func(t *testing.T) {
+		transport := &testTransport{}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		chainAddress, err := client.ReverseResolveAddress(roochAddr, address.MultiChainIDRooch, address.BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, roochAddr, chainAddress.(*address.RoochAddress).StringLong())
+		// A Rooch address is its own mapping
+		assert.Empty(t, transport.methods)
+	})
+
+	t.Run("bitcoin", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{
+			"rooch_executeViewFunction": testViewResult("0x1::option::Option<0x3::bitcoin_address::BitcoinAddress>", "0x0116"+testWitnessAddress),
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		chainAddress, err := client.ReverseResolveAddress(roochAddr, address.MultiChainIDBitcoin, address.BitcoinNetworkRegtest)
+		assert.NoError(t, err)
+		assert.Equal(t, "bcrt1qcr8te4kr609gcawutmrza0j4xv80jy8zeqchgx", chainAddress.(*address.BitcoinAddress).String())
+	})
+
+	t.Run("bitcoin without mapping", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{
+			"rooch_executeViewFunction": testViewResult("0x1::option::Option<0x3::bitcoin_address::BitcoinAddress>", "0x00"),
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		chainAddress, err := client.ReverseResolveAddress(roochAddr, address.MultiChainIDBitcoin, address.BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Nil(t, chainAddress)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		client := NewRoochClient(RoochClientOptions{Transport: &testTransport{}})
+		_, err := client.ReverseResolveAddress(roochAddr, 60, address.BitcoinNetworkBitcoin)
+		assert.Error(t, err)
+	})
+}
+
+// testObjectStateJSON is the JSON view of an object state owned by 0x42
+func testObjectStateJSON(id string, objectType string, value string) string {
+	return `{
+    "id": "` + id + `",
+    "owner": "0x0000000000000000000000000000000000000000000000000000000000000042",
+    "owner_bitcoin_address": null,
+    "flag": 0,
+    "state_root": null,
+    "size": "1",
+    "created_at": "10",
+    "updated_at": "20",
+    "object_type": "` + objectType + `",
+    "value": "` + value + `",
+    "decoded_value": null,
+    "display_fields": null
+  }`
+}
+
+func TestGetStatesWithProof(t *testing.T) {
+	id := types.NewObjectID([]types.RoochAddress{types.RoochAddress{0x21}})
+	stateJSON := testObjectStateJSON(id.String(), "0x3::coin_store::CoinStore<0x3::gas_coin::RGas>", "0x07")
+	state := &types.ObjectStateView{}
+	assert.NoError(t, json.Unmarshal([]byte(stateJSON), state))
+
+	// The object is the only leaf of the state tree, the root is the hash of the leaf
+	valueHash, err := state.ValueHash()
+	assert.NoError(t, err)
+	leaf := types.SparseMerkleLeaf{Key: types.H256(id.Address[0]), ValueHash: valueHash}
+	stateRoot := leaf.Hash()
+	proof, err := bcs.Serialize(&types.SparseMerkleProof{Leaf: &leaf})
+	assert.NoError(t, err)
+
+	newTransport := func(stateRoot types.H256) *testTransport {
+		return &testTransport{results: map[string]string{"rooch_getStatesWithProof": `[{
+  "id": "` + id.String() + `",
+  "state_root": "` + stateRoot.String() + `",
+  "state": ` + stateJSON + `,
+  "proof": {"parents": [], "proofs": ["0x` + hex.EncodeToString(proof) + `"]}
+}]`}}
+	}
+	accessPath := "/object/" + id.String()
+
+	t.Run("states with proof", func(t *testing.T) {
+		transport := newTransport(stateRoot)
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		results, err := client.GetStatesWithProof(GetStatesParams{AccessPath: accessPath, Anchor: AtStateRoot(stateRoot)})
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, id, results[0].ID)
+		assert.Equal(t, stateRoot, results[0].StateRoot)
+		assert.Equal(t, state.ObjectState, results[0].State.ObjectState)
+		assert.Len(t, results[0].Proof.Proofs, 1)
+
+		assert.Equal(t, []string{"rooch_getStatesWithProof"}, transport.methods)
+		root := stateRoot.String()
+		assert.Equal(t, []interface{}{accessPath, &StateOption{StateRoot: &root}}, transport.params[0])
+	})
+
+	t.Run("verified states", func(t *testing.T) {
+		client := NewRoochClient(RoochClientOptions{Transport: newTransport(stateRoot)})
+		states, err := client.GetVerifiedStates(accessPath, stateRoot)
+		assert.NoError(t, err)
+		assert.Equal(t, []*types.ObjectStateView{state}, states)
+	})
+
+	t.Run("untrusted state root", func(t *testing.T) {
+		// The node answers under another root than the trusted one
+		client := NewRoochClient(RoochClientOptions{Transport: newTransport(types.H256{0x01})})
+		_, err := client.GetVerifiedStates(accessPath, stateRoot)
+		assert.ErrorIs(t, err, types.ErrInvalidStateProof)
+	})
+}
+
+func TestGetObjectEntities(t *testing.T) {
+	id := types.NewObjectID([]types.RoochAddress{types.RoochAddress{0x21}})
+	missingID := types.NewObjectID([]types.RoochAddress{types.RoochAddress{0x22}})
+	objectIDs := id.String() + "," + missingID.String()
+	// A CoinStore of balance 42 that is frozen, the balance is a little endian u256
+	coinStore := "0x2a" + strings.Repeat("00", 31) + "01"
+	newTransport := func(value string) *testTransport {
+		return &testTransport{results: map[string]string{
+			"rooch_getObjectStates": `[` + testObjectStateJSON(id.String(), "0x3::coin_store::CoinStore<0x3::gas_coin::RGas>", value) + `, null]`,
+		}}
+	}
+
+	t.Run("object states", func(t *testing.T) {
+		transport := newTransport(coinStore)
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		states, err := client.GetObjectStates(GetObjectStatesParams{ObjectIDs: objectIDs})
+		assert.NoError(t, err)
+		assert.Len(t, states, 2)
+		assert.Equal(t, id, states[0].Metadata.ID)
+		// The state of the missing object is nil
+		assert.Nil(t, states[1])
+
+		assert.Equal(t, []string{"rooch_getObjectStates"}, transport.methods)
+		assert.Equal(t, []interface{}{objectIDs, (*StateOption)(nil)}, transport.params[0])
+	})
+
+	t.Run("object entities", func(t *testing.T) {
+		client := NewRoochClient(RoochClientOptions{Transport: newTransport(coinStore)})
+		entities, err := GetObjectEntities[types.CoinStore](client, GetObjectStatesParams{ObjectIDs: objectIDs})
+		assert.NoError(t, err)
+		assert.Len(t, entities, 2)
+		assert.Equal(t, id, entities[0].ID)
+		assert.Equal(t, uint64(20), entities[0].UpdatedAt)
+		assert.Equal(t, big.NewInt(42), entities[0].Value.Balance)
+		assert.True(t, entities[0].Value.Frozen)
+		assert.Nil(t, entities[1])
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		client := NewRoochClient(RoochClientOptions{Transport: newTransport("0x2a")})
+		_, err := GetObjectEntities[types.CoinStore](client, GetObjectStatesParams{ObjectIDs: objectIDs})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+// DisplayedObject is an object with its value decoded into the typed Move struct view T and its
+// rendered display fields, Display is nil when the type of the object has no display
+type DisplayedObject[T any] struct {
+	Entity  *types.ObjectEntity[T]
+	Display map[string]string
+}
+
+// GetDisplay fetches the 0x2::display::Display of the object type, nil when the type has no display
+func (c *RoochClient) GetDisplay(objectType *types.StructTag, anchor *StateAnchor) (*types.Display, error) {
+	id := types.DisplayObjectID(objectType)
+	states, err := c.GetObjectStates(GetObjectStatesParams{ObjectIDs: id.String(), Anchor: anchor})
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 || states[0] == nil {
+		return nil, nil
+	}
+	display := &types.Display{}
+	if err := states[0].DecodeValue(display); err != nil {
+		return nil, fmt.Errorf("failed to decode display of %s: %w", objectType.String(), err)
+	}
+	return display, nil
+}
+
+// RenderObjectDisplay fetches the object and the display of its type, and renders the display locally
+// against the decoded fields of the object. It returns nil when the type has no display
+func (c *RoochClient) RenderObjectDisplay(objectID string, anchor *StateAnchor) (map[string]string, error) {
+	states, err := c.GetObjectStates(GetObjectStatesParams{
+		ObjectIDs:   objectID,
+		StateOption: &StateOption{Decode: true},
+		Anchor:      anchor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 || states[0] == nil {
+		return nil, fmt.Errorf("object %s not found", objectID)
+	}
+	return c.renderDisplay(states[0], anchor, map[string]*types.Display{})
+}
+
+// GetDisplayedObjects fetches the objects with their value decoded into the typed Move struct view T and
+// their display rendered, the display of each object type is fetched once. A missing object is nil
+func GetDisplayedObjects[T any](c *RoochClient, params GetObjectStatesParams) ([]*DisplayedObject[T], error) {
+	anchor, err := c.Snapshot(params.Anchor)
+	if err != nil {
+		return nil, err
+	}
+	stateOption := StateOption{}
+	if params.StateOption != nil {
+		stateOption = *params.StateOption
+	}
+	stateOption.Decode = true
+	params.StateOption = &stateOption
+	params.Anchor = anchor
+
+	states, err := c.GetObjectStates(params)
+	if err != nil {
+		return nil, err
+	}
+	displays := map[string]*types.Display{}
+	objects := make([]*DisplayedObject[T], len(states))
+	for i, state := range states {
+		if state == nil {
+			continue
+		}
+		entity, err := types.NewObjectEntity[T](&state.ObjectState)
+		if err != nil {
+			return nil, err
+		}
+		display, err := c.renderDisplay(state, anchor, displays)
+		if err != nil {
+			return nil, err
+		}
+		objects[i] = &DisplayedObject[T]{Entity: entity, Display: display}
+	}
+	return objects, nil
+}
+
+// renderDisplay renders the display of the type of the object, displays caches the displays by type
+func (c *RoochClient) renderDisplay(state *types.ObjectStateView, anchor *StateAnchor, displays map[string]*types.Display) (map[string]string, error) {
+	objectType, err := types.TypeTagAsStructTag(&state.Metadata.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+	key := objectType.ToCanonicalString()
+	display, ok := displays[key]
+	if !ok {
+		display, err = c.GetDisplay(objectType, anchor)
+		if err != nil {
+			return nil, err
+		}
+		displays[key] = display
+	}
+	if display == nil {
+		return nil, nil
+	}
+	return state.RenderDisplay(display)
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testNFT is the Move struct 0x42::nft::NFT { name: String }
+type testNFT struct {
+	Name string
+}
+
+func (n *testNFT) MarshalBCS(ser *bcs.Serializer) {
+	ser.WriteString(n.Name)
+}
+func (n *testNFT) UnmarshalBCS(des *bcs.Deserializer) {
+	n.Name = des.ReadString()
+}
+
+// testNFTStateJSON is the JSON view of an NFT object with its BCS and decoded value
+func testNFTStateJSON(t *testing.T, id types.ObjectID, name string) string {
+	value, err := bcs.Serialize(&testNFT{Name: name})
+	assert.NoError(t, err)
+	state := testObjectStateJSON(id.String(), "0x42::nft::NFT", "0x"+hex.EncodeToString(value))
+	return strings.Replace(state, `"decoded_value": null`,
+		`"decoded_value": {"abilities": 12, "type": "0x42::nft::NFT", "value": {"name": "`+name+`"}}`, 1)
+}
+
+func TestDisplay(t *testing.T) {
+	nftType, err := types.ParseStructTag("0x42::nft::NFT")
+	assert.NoError(t, err)
+	displayID := types.DisplayObjectID(nftType)
+	display := &types.Display{Fields: []types.DisplayEntry{
+		{Key: "name", Value: "{value.name}"},
+		{Key: "image_url", Value: "https://nft.rooch.network/{id}.png"},
+	}}
+	displayValue, err := bcs.Serialize(display)
+	assert.NoError(t, err)
+	displayJSON := `[` + testObjectStateJSON(displayID.String(), "0x2::display::Display<0x42::nft::NFT>", "0x"+hex.EncodeToString(displayValue)) + `]`
+
+	id1 := types.NewObjectID([]types.RoochAddress{types.RoochAddress{0x21}})
+	id2 := types.NewObjectID([]types.RoochAddress{types.RoochAddress{0x22}})
+	missingID := types.NewObjectID([]types.RoochAddress{types.RoochAddress{0x23}})
+
+	t.Run("get display", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{"rooch_getObjectStates " + displayID.String(): displayJSON}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		fetched, err := client.GetDisplay(nftType, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, display, fetched)
+	})
+
+	t.Run("no display", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{"rooch_getObjectStates": `[null]`}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		fetched, err := client.GetDisplay(nftType, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, fetched)
+	})
+
+	t.Run("render object display", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{
+			"rooch_getObjectStates " + id1.String():       `[` + testNFTStateJSON(t, id1, "Rooch #1") + `]`,
+			"rooch_getObjectStates " + displayID.String(): displayJSON,
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		rendered, err := client.RenderObjectDisplay(id1.String(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"name":      "Rooch #1",
+			"image_url": "https://nft.rooch.network/" + id1.String() + ".png",
+		}, rendered)
+		// The object is fetched decoded
+		assert.Equal(t, &StateOption{Decode: true}, transport.params[0][1])
+	})
+
+	t.Run("render missing object", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{"rooch_getObjectStates": `[null]`}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		_, err := client.RenderObjectDisplay(missingID.String(), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("displayed objects", func(t *testing.T) {
+		objectIDs := id1.String() + "," + missingID.String() + "," + id2.String()
+		transport := &testTransport{results: map[string]string{
+			"rooch_getObjectStates " + objectIDs: `[` + testNFTStateJSON(t, id1, "Rooch #1") + `, null, ` +
+				testNFTStateJSON(t, id2, "Rooch #2") + `]`,
+			"rooch_getObjectStates " + displayID.String(): displayJSON,
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		objects, err := GetDisplayedObjects[testNFT](client, GetObjectStatesParams{ObjectIDs: objectIDs})
+		assert.NoError(t, err)
+		assert.Len(t, objects, 3)
+		assert.Equal(t, "Rooch #1", objects[0].Entity.Value.Name)
+		assert.Equal(t, "Rooch #1", objects[0].Display["name"])
+		assert.Nil(t, objects[1])
+		assert.Equal(t, "Rooch #2", objects[2].Entity.Value.Name)
+		assert.Equal(t, "https://nft.rooch.network/"+id2.String()+".png", objects[2].Display["image_url"])
+		// The display of the type is fetched once for both objects
+		assert.Equal(t, []string{"rooch_getObjectStates", "rooch_getObjectStates"}, transport.methods)
+	})
+
+	t.Run("displayed objects without display", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{
+			"rooch_getObjectStates " + id1.String():       `[` + testNFTStateJSON(t, id1, "Rooch #1") + `]`,
+			"rooch_getObjectStates " + displayID.String(): `[null]`,
+		}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		objects, err := GetDisplayedObjects[testNFT](client, GetObjectStatesParams{ObjectIDs: id1.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, "Rooch #1", objects[0].Entity.Value.Name)
+		assert.Nil(t, objects[0].Display)
+	})
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rooch-network/rooch-go-sdk/api"
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+var ErrTxOrderNotFound = errors.New("transaction order not found")
+
+// StateAnchor pins a read to a historical state, either a state root or the state after the
+// transaction with the tx order was executed. Reads with the same anchor see a consistent snapshot
+type StateAnchor struct {
+	StateRoot *types.H256
+	TxOrder   *uint64
+}
+
+// AtStateRoot anchors reads at the state root
+func AtStateRoot(stateRoot types.H256) *StateAnchor {
+	return &StateAnchor{StateRoot: &stateRoot}
+}
+
+// AtTxOrder anchors reads at the state after the transaction with the tx order
+func AtTxOrder(txOrder uint64) *StateAnchor {
+	return &StateAnchor{TxOrder: &txOrder}
+}
+
+// GetTransactionsByOrder lists the transactions after the cursor tx order, from the first
+// transaction when cursor is nil
+func (c *RoochClient) GetTransactionsByOrder(cursor *uint64, limit uint64, descendingOrder bool) (*api.TransactionPage, error) {
+	var cursorParam *string
+	if cursor != nil {
+		value := fmt.Sprintf("%d", *cursor)
+		cursorParam = &value
+	}
+	var result api.TransactionPage
+	err := c.transport.Request("rooch_getTransactionsByOrder", []interface{}{
+		cursorParam,
+		fmt.Sprintf("%d", limit),
+		descendingOrder,
+	}, &result)
+	return &result, err
+}
+
+// ResolveStateRoot returns the state root of the anchor, a tx order is resolved to the state root
+// of the execution info of the transaction. A nil anchor resolves to nil, the latest state
+func (c *RoochClient) ResolveStateRoot(anchor *StateAnchor) (*types.H256, error) {
+	if anchor == nil {
+		return nil, nil
+	}
+	if anchor.StateRoot != nil {
+		return anchor.StateRoot, nil
+	}
+	if anchor.TxOrder == nil {
+		return nil, nil
+	}
+
+	// The cursor is exclusive, the transaction is the one after txOrder-1. Tx order 0 has no
+	// previous transaction and is the first one listed without a cursor
+	txOrder := *anchor.TxOrder
+	var cursor *uint64
+	if txOrder != 0 {
+		previous := txOrder - 1
+		cursor = &previous
+	}
+	page, err := c.GetTransactionsByOrder(cursor, 1, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(page.Data) == 0 || page.Data[0].Transaction.SequenceInfo.TxOrder != txOrder {
+		return nil, fmt.Errorf("%w: %d", ErrTxOrderNotFound, txOrder)
+	}
+	executionInfo := page.Data[0].ExecutionInfo
+	if executionInfo == nil {
+		return nil, fmt.Errorf("transaction %d is not executed yet", txOrder)
+	}
+	return &executionInfo.StateRoot, nil
+}
+
+// Snapshot resolves the anchor once into a state root anchor, so that a tx order is not resolved
+// again by every read of a report
+func (c *RoochClient) Snapshot(anchor *StateAnchor) (*StateAnchor, error) {
+	stateRoot, err := c.ResolveStateRoot(anchor)
+	if err != nil || stateRoot == nil {
+		return anchor, err
+	}
+	return AtStateRoot(*stateRoot), nil
+}
+
+// anchoredStateOption returns the state option with the state root of the anchor, option is not modified
+func (c *RoochClient) anchoredStateOption(option *StateOption, anchor *StateAnchor) (*StateOption, error) {
+	stateRoot, err := c.ResolveStateRoot(anchor)
+	if err != nil || stateRoot == nil {
+		return option, err
+	}
+	anchored := StateOption{}
+	if option != nil {
+		anchored = *option
+	}
+	root := stateRoot.String()
+	anchored.StateRoot = &root
+	return &anchored, nil
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/api"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testTransport records the requests and answers them with the next of the queued JSON pages of the
+// method, or else the JSON result of the method and its first param, e.g. "rooch_getObjectStates 0x01",
+// or else of the method, or else with the page
+type testTransport struct {
+	methods []string
+	params  [][]interface{}
+	pages   map[string][]string
+	results map[string]string
+	page    api.TransactionPage
+}
+
+func (tt *testTransport) Request(method string, params []interface{}, result interface{}) error {
+	tt.methods = append(tt.methods, method)
+	tt.params = append(tt.params, params)
+	if pages := tt.pages[method]; len(pages) > 0 {
+		tt.pages[method] = pages[1:]
+		return json.Unmarshal([]byte(pages[0]), result)
+	}
+	if len(params) > 0 {
+		if first, ok := params[0].(string); ok {
+			if raw, ok := tt.results[method+" "+first]; ok {
+				return json.Unmarshal([]byte(raw), result)
+			}
+		}
+	}
+	if raw, ok := tt.results[method]; ok {
+		return json.Unmarshal([]byte(raw), result)
+	}
+	*result.(*api.TransactionPage) = tt.page
+	return nil
+}
+
+func testTransaction(txOrder uint64, stateRoot *types.H256) *api.Transaction {
+	tx := &api.Transaction{}
+	tx.Transaction.SequenceInfo.TxOrder = txOrder
+	if stateRoot != nil {
+		tx.ExecutionInfo = &types.TransactionExecutionInfo{StateRoot: *stateRoot}
+	}
+	return tx
+}
+
+func TestResolveStateRoot(t *testing.T) {
+	stateRoot := types.H256{0x5e}
+
+	t.Run("tx order", func(t *testing.T) {
+		transport := &testTransport{page: api.TransactionPage{Data: []*api.Transaction{testTransaction(5, &stateRoot)}}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		root, err := client.ResolveStateRoot(AtTxOrder(5))
+		assert.NoError(t, err)
+		assert.Equal(t, stateRoot, *root)
+
+		// The cursor is exclusive, the page of one transaction after tx order 4
+		assert.Equal(t, []string{"rooch_getTransactionsByOrder"}, transport.methods)
+		cursor := "4"
+		assert.Equal(t, []interface{}{&cursor, "1", false}, transport.params[0])
+	})
+
+	t.Run("tx order 0", func(t *testing.T) {
+		transport := &testTransport{page: api.TransactionPage{Data: []*api.Transaction{testTransaction(0, &stateRoot)}}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		root, err := client.ResolveStateRoot(AtTxOrder(0))
+		assert.NoError(t, err)
+		assert.Equal(t, stateRoot, *root)
+		// No cursor lists from the first transaction
+		assert.Equal(t, []interface{}{(*string)(nil), "1", false}, transport.params[0])
+	})
+
+	t.Run("json response", func(t *testing.T) {
+		transport := &testTransport{results: map[string]string{"rooch_getTransactionsByOrder": `{
+  "data": [{
+    "transaction": {
+      "data": {"type": "l1_block", "chain_id": "1", "block_height": "840000", "block_hash": "0x` + strings.Repeat("01", 32) + `", "bitcoin_block_hash": "` + strings.Repeat("02", 32) + `"},
+      "sequence_info": {"tx_order": "9", "tx_order_signature": "0x", "tx_accumulator_root": "0x` + strings.Repeat("11", 32) + `", "tx_timestamp": "1713000000000", "tx_accumulator_frozen_subtree_roots": [], "tx_accumulator_num_leaves": "10", "tx_accumulator_num_nodes": "19"}
+    },
+    "execution_info": {"tx_hash": "0x` + strings.Repeat("22", 32) + `", "state_root": "0x` + strings.Repeat("33", 32) + `", "event_root": "0x` + strings.Repeat("44", 32) + `", "size": "1000", "gas_used": "0", "status": {"type": "executed"}}
+  }],
+  "next_cursor": "9",
+  "has_next_page": true
+}`}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		root, err := client.ResolveStateRoot(AtTxOrder(9))
+		assert.NoError(t, err)
+		assert.Equal(t, "0x"+strings.Repeat("33", 32), root.String())
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		client := NewRoochClient(RoochClientOptions{Transport: &testTransport{}})
+		_, err := client.ResolveStateRoot(AtTxOrder(7))
+		assert.ErrorIs(t, err, ErrTxOrderNotFound)
+	})
+
+	t.Run("other tx order", func(t *testing.T) {
+		transport := &testTransport{page: api.TransactionPage{Data: []*api.Transaction{testTransaction(8, &stateRoot)}}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		_, err := client.ResolveStateRoot(AtTxOrder(7))
+		assert.ErrorIs(t, err, ErrTxOrderNotFound)
+	})
+
+	t.Run("no execution info", func(t *testing.T) {
+		transport := &testTransport{page: api.TransactionPage{Data: []*api.Transaction{testTransaction(7, nil)}}}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		root, err := client.ResolveStateRoot(AtTxOrder(7))
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrTxOrderNotFound)
+		assert.Nil(t, root)
+	})
+
+	t.Run("state root and latest state", func(t *testing.T) {
+		transport := &testTransport{}
+		client := NewRoochClient(RoochClientOptions{Transport: transport})
+		root, err := client.ResolveStateRoot(AtStateRoot(stateRoot))
+		assert.NoError(t, err)
+		assert.Equal(t, stateRoot, *root)
+		root, err = client.ResolveStateRoot(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, root)
+		assert.Empty(t, transport.methods)
+	})
+}
+
+func TestAnchoredStateOption(t *testing.T) {
+	stateRoot := types.H256{0x5e}
+	transport := &testTransport{page: api.TransactionPage{Data: []*api.Transaction{testTransaction(3, &stateRoot)}}}
+	client := NewRoochClient(RoochClientOptions{Transport: transport})
+
+	option := &StateOption{Decode: true}
+	anchored, err := client.anchoredStateOption(option, AtTxOrder(3))
+	assert.NoError(t, err)
+	assert.True(t, anchored.Decode)
+	assert.Equal(t, stateRoot.String(), *anchored.StateRoot)
+	// The option of the caller is not modified
+	assert.Nil(t, option.StateRoot)
+
+	anchored, err = client.anchoredStateOption(nil, AtTxOrder(3))
+	assert.NoError(t, err)
+	assert.Equal(t, stateRoot.String(), *anchored.StateRoot)
+
+	anchored, err = client.anchoredStateOption(option, nil)
+	assert.NoError(t, err)
+	assert.Same(t, option, anchored)
+
+	transport.page = api.TransactionPage{}
+	_, err = client.anchoredStateOption(option, AtTxOrder(3))
+	assert.ErrorIs(t, err, ErrTxOrderNotFound)
+}
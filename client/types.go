@@ -1,21 +1,23 @@
 package client
 
-import (
-	"github.com/rooch-network/rooch-go-sdk/address"
-	"github.com/rooch-network/rooch-go-sdk/crypto"
-	"math/big"
-
-	"github.com/rooch-network/rooch-go-sdk/types"
-)
+//import (
+//	"github.com/rooch-network/rooch-go-sdk/crypto"
+//	"math/big"
+//
+//	"github.com/rooch-network/rooch-go-sdk/types"
+//)
 
 type GetStatesParams struct {
 	AccessPath  string
 	StateOption *StateOption
+	// Anchor reads the historical state, the latest state when nil
+	Anchor *StateAnchor
 }
 
 type StateOption struct {
-	Decode      bool
-	ShowDisplay bool
+	Decode      bool    `json:"decode"`
+	ShowDisplay bool    `json:"showDisplay"`
+	StateRoot   *string `json:"stateRoot,omitempty"`
 }
 
 type ListStatesParams struct {
@@ -23,6 +25,32 @@ type ListStatesParams struct {
 	Cursor      string
 	Limit       string
 	StateOption *StateOption
+	// Anchor reads the historical state, the latest state when nil
+	Anchor *StateAnchor
+}
+
+type GetObjectStatesParams struct {
+	ObjectIDs   string
+	StateOption *StateOption
+	// Anchor reads the historical state, the latest state when nil
+	Anchor *StateAnchor
+}
+
+type GetFieldStatesParams struct {
+	ObjectID    string
+	FieldKey    []string
+	StateOption *StateOption
+	// Anchor reads the historical state, the latest state when nil
+	Anchor *StateAnchor
+}
+
+type ListFieldStatesParams struct {
+	ObjectID    string
+	Cursor      string
+	Limit       string
+	StateOption *StateOption
+	// Anchor reads the historical state, the latest state when nil
+	Anchor *StateAnchor
 }
 
 type GetModuleABIParams struct {
@@ -90,16 +118,16 @@ type GetBalancesParams struct {
 	Limit  string
 }
 
-type TransferParams struct {
-	Signer    crypto.Signer[address.RoochAddress]
-	Recipient string
-	Amount    *big.Int
-	CoinType  types.TypeArgs
-}
-
-type TransferObjectParams struct {
-	Signer     crypto.Signer
-	Recipient  string
-	ObjectID   string
-	ObjectType types.TypeArgs
-}
+//type TransferParams struct {
+//	Signer    crypto.Signer
+//	Recipient string
+//	Amount    *big.Int
+//	CoinType  types.TypeArgs
+//}
+//
+//type TransferObjectParams struct {
+//	Signer     crypto.Signer
+//	Recipient  string
+//	ObjectID   string
+//	ObjectType types.TypeArgs
+//}
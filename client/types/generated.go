@@ -442,9 +442,6 @@ func (t TransactionStatusView) IsFailed() bool {
 	return t == TransactionStatusFailed
 }
 
-// VMStatusView represents the status of a VM operation
-type VMStatusView interface{}
-
 // VMErrorInfo represents VM error information
 type VMErrorInfo struct {
 	ErrorMessage   string   `json:"error_message"`
@@ -1062,7 +1059,7 @@ type ErrorContext struct {
 func NewErrorWithContext(code int, message string, context ErrorContext) *RoochError {
 	return &RoochError{
 		Code:    code,
-		Message: fmt.Sprintf("%s: %s (Operation: %s)", message, formatErrorContext(context)),
+		Message: fmt.Sprintf("%s: %s", message, formatErrorContext(context)),
 	}
 }
 
@@ -28,3 +28,23 @@ type SessionInfoView struct {
 	LastActiveTime      int64    `json:"lastActiveTime"`
 	MaxInactiveInterval int64    `json:"maxInactiveInterval"`
 }
+
+// TxOptions represents the options for executing a transaction
+type TxOptions struct {
+	WithOutput *bool `json:"withOutput,omitempty"`
+}
+
+// UTXOFilterView represents different types of UTXO filters, e.g. {"owner": "bc1q..."}
+type UTXOFilterView interface{}
+
+// ObjectStateFilterView represents different types of object state filters, e.g. {"owner": "0x..."}
+type ObjectStateFilterView interface{}
+
+// TransactionFilterView represents different types of transaction filters, e.g. {"sender": "0x..."}
+type TransactionFilterView interface{}
+
+// SyncStateFilterView represents different types of state change set filters
+type SyncStateFilterView interface{}
+
+// RepairIndexerParamsView represents the objects of an indexer repair
+type RepairIndexerParamsView interface{}
@@ -0,0 +1,40 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+var ErrNoDecodedValue = errors.New("no decoded value, request it with the decode state option")
+
+// VMStatusView represents the status of a VM operation, typed by [types.VMStatus] rather than the
+// untyped view of the OpenRPC specification
+type VMStatusView = types.VMStatus
+
+// Decode converts the annotated Move struct into a typed Move struct view of the types package,
+// e.g. types.CoinStore or types.UTXO
+func (v *AnnotatedMoveStructView) Decode(out any) error {
+	if v == nil {
+		return ErrNoDecodedValue
+	}
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, out)
+}
+
+// DecodeValue converts the decoded value of the object into a typed Move struct view
+func (v *IndexerObjectStateView) DecodeValue(out any) error {
+	return v.DecodedValue.Decode(out)
+}
+
+// DecodeValue converts the decoded value of the state into a typed Move struct view
+func (v *StateValue) DecodeValue(out any) error {
+	return v.DecodedValue.Decode(out)
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
-	"github.com/rooch-network/rooch-go-sdk/address"
 	"github.com/rooch-network/rooch-go-sdk/bcs"
 	"github.com/rooch-network/rooch-go-sdk/utils"
 	"strings"
@@ -82,15 +81,17 @@ func (b *BitcoinSignMessage) Hash() []byte {
 //export enum BuiltinAuthValidator {
 //ROOCH = 0x00,
 //BITCOIN = 0x01,
-//// ETHEREUM= 0x02
+//BITCOIN_MULTISIGN = 0x02,
+//ETHEREUM = 0x03
 //}
 
 type AuthValidatorType uint64
 
 const (
-	AuthValidatorTypeRooch   AuthValidatorType = 0x00
-	AuthValidatorTypeBitcoin AuthValidatorType = 0x01
-	//AuthValidatorTypeEthereum AuthValidatorType = 0x02
+	AuthValidatorTypeRooch            AuthValidatorType = 0x00
+	AuthValidatorTypeBitcoin          AuthValidatorType = 0x01
+	AuthValidatorTypeBitcoinMultisign AuthValidatorType = 0x02
+	AuthValidatorTypeEthereum         AuthValidatorType = 0x03
 )
 
 //const (
@@ -129,7 +130,7 @@ func (au *Authenticator) UnmarshalBCS(des *bcs.Deserializer) {
 //return new Authenticator(BuiltinAuthValidator.ROOCH, serializedSignature)
 //}
 
-func RoochAuthValidator(input []byte, signer Signer[address.RoochAddress]) (*Authenticator, error) {
+func RoochAuthValidator(input []byte, signer Signer) (*Authenticator, error) {
 	signature, err := signer.Sign(input)
 	if err != nil {
 		return nil, err
@@ -151,7 +152,7 @@ func RoochAuthValidator(input []byte, signer Signer[address.RoochAddress]) (*Aut
 		uint64(AuthValidatorTypeRooch), serializedSignature}, nil
 }
 
-func BitcoinAuthValidator(input *BitcoinSignMessage, signer Signer[address.AddressView], signWith string) (*Authenticator, error) {
+func BitcoinAuthValidator(input *BitcoinSignMessage, signer Signer, signWith string) (*Authenticator, error) {
 	if !strings.HasPrefix(input.messageInfo, MessageInfoPrefix) {
 		return nil, errors.New("invalid message info")
 	}
@@ -193,3 +194,33 @@ func BitcoinAuthValidator(input *BitcoinSignMessage, signer Signer[address.Addre
 	return &Authenticator{
 		uint64(AuthValidatorTypeBitcoin), payload}, nil
 }
+
+// BitcoinMultisignAuthValidator builds the multisign authenticator from signatures already
+// collected over input.Hash(), signatures[i] must be produced by publicKeys[i]
+func BitcoinMultisignAuthValidator(input *BitcoinSignMessage, signatures [][]byte, publicKeys [][]byte) (*Authenticator, error) {
+	if !strings.HasPrefix(input.messageInfo, MessageInfoPrefix) {
+		return nil, errors.New("invalid message info")
+	}
+	if len(signatures) == 0 || len(signatures) != len(publicKeys) {
+		return nil, errors.New("signatures and public keys mismatch")
+	}
+
+	messageLength := len([]byte(input.messageInfo)) + len(hex.EncodeToString(input.txHash))
+
+	multisignPayload := MultisignAuthPayload{
+		Signatures: signatures,
+		MessagePrefix: bytes.Join([][]byte{
+			[]byte(input.messagePrefix),
+			utils.VarintByteNum(uint64(messageLength)),
+		}, []byte{}),
+		MessageInfo: []byte(input.messageInfo),
+		PublicKeys:  publicKeys,
+	}
+	payload, err := bcs.Serialize(&multisignPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		uint64(AuthValidatorTypeBitcoinMultisign), payload}, nil
+}
@@ -0,0 +1,93 @@
+package crypto
+
+import (
+	"errors"
+	"fmt"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+)
+
+const EthereumMessagePrefix = "\x19Ethereum Signed Message:\n"
+
+// RecoverableSigner is a Secp256k1 [Signer] that can also sign a prehashed digest
+// and return the 65 bytes r || s || v signature with v in {0, 1}
+type RecoverableSigner interface {
+	Signer
+
+	SignRecoverable(digest []byte) ([]byte, error)
+}
+
+// EthereumSignMessageHash returns the EIP-191 personal message hash of data, this is what MetaMask
+// style wallets sign with personal_sign
+func EthereumSignMessageHash(data []byte) []byte {
+	msg := fmt.Sprintf("%s%d%s", EthereumMessagePrefix, len(data), data)
+	return ethCrypto.Keccak256([]byte(msg))
+}
+
+// RecoverEthereumAddress recovers the signer of an EIP-191 signature over data, v may be 0/1 or 27/28
+func RecoverEthereumAddress(data []byte, signature []byte) (*address.EthereumAddress, error) {
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("invalid ethereum signature length %d", len(signature))
+	}
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	publicKey, err := ethCrypto.SigToPub(EthereumSignMessageHash(data), sig)
+	if err != nil {
+		return nil, err
+	}
+	return address.NewEthereumAddressFromBytes(ethCrypto.PubkeyToAddress(*publicKey).Bytes())
+}
+
+// EthereumAuthPayload is the payload of the Ethereum authenticator, the signature is
+// the 65 bytes r || s || v with v in {27, 28} over the EIP-191 hash of the tx hash
+type EthereumAuthPayload struct {
+	Signature   []byte
+	FromAddress []byte
+}
+
+func (eap *EthereumAuthPayload) MarshalBCS(ser *bcs.Serializer) {
+	ser.WriteBytes(eap.Signature)
+	ser.WriteBytes(eap.FromAddress)
+}
+
+func (eap *EthereumAuthPayload) UnmarshalBCS(des *bcs.Deserializer) {
+	eap.Signature = des.ReadBytes()
+	eap.FromAddress = des.ReadBytes()
+}
+
+// EthereumAuthValidator signs the EIP-191 personal message of input and builds the Ethereum authenticator
+func EthereumAuthValidator(input []byte, signer RecoverableSigner) (*Authenticator, error) {
+	if signer.GetKeyScheme() != Secp256k1Scheme {
+		return nil, errors.New("ethereum authenticator requires a Secp256k1 key")
+	}
+	signature, err := signer.SignRecoverable(EthereumSignMessageHash(input))
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("invalid recoverable signature length %d", len(signature))
+	}
+	signature[64] += 27
+
+	ethereumAddress, err := address.EthereumAddressFromPublicKey(signer.GetPublicKey().ToBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	ethereumPayload := EthereumAuthPayload{
+		Signature:   signature,
+		FromAddress: ethereumAddress.ToBytes(),
+	}
+	payload, err := bcs.Serialize(&ethereumPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		uint64(AuthValidatorTypeEthereum), payload}, nil
+}
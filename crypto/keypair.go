@@ -77,7 +77,7 @@ func EncodeRoochSecretKey(bytes []byte, scheme SignatureScheme) (string, error)
 	flag, ok := SignatureSchemeToFlag[scheme]
 	if ok != true {
 		return "", errors.New(
-			fmt.Sprintf("invalid signature scheme %d", scheme))
+			fmt.Sprintf("invalid signature scheme %s", scheme))
 	}
 
 	// Combine flag and private key bytes
@@ -86,7 +86,11 @@ func EncodeRoochSecretKey(bytes []byte, scheme SignatureScheme) (string, error)
 	copy(privKeyBytes[1:], bytes)
 
 	// Encode the combined bytes to Bech32
-	encoded, err := bech32.Encode(RoochSecretKeyPrefix, privKeyBytes)
+	words, err := bech32.ConvertBits(privKeyBytes, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	encoded, err := bech32.Encode(RoochSecretKeyPrefix, words)
 	if err != nil {
 		return "", err
 	}
@@ -80,8 +80,8 @@ func TestEncodeRoochSecretKey(t *testing.T) {
 		scheme := Ed25519Scheme
 
 		_, err := EncodeRoochSecretKey(invalidPrivateKey, scheme)
-		if err == nil || err.Error() != "Invalid bytes length" {
-			t.Error("expected Invalid bytes length error")
+		if err == nil || err.Error() != "invalid bytes length" {
+			t.Error("expected invalid bytes length error")
 		}
 	})
 
@@ -0,0 +1,47 @@
+package crypto
+
+import "github.com/rooch-network/rooch-go-sdk/bcs"
+
+//pub struct MultisignAuthPayload {
+//pub signatures: Vec<Vec<u8>>,
+//pub message_prefix: Vec<u8>,
+//pub message_info: Vec<u8>,
+//pub public_keys: Vec<Vec<u8>>,
+//}
+
+// MultisignAuthPayload is the payload of the Bitcoin multisign authenticator,
+// signatures[i] must be produced by public_keys[i]
+type MultisignAuthPayload struct {
+	Signatures    [][]byte
+	MessagePrefix []byte
+	MessageInfo   []byte
+	PublicKeys    [][]byte
+}
+
+func (mp *MultisignAuthPayload) MarshalBCS(ser *bcs.Serializer) {
+	ser.Uleb128(uint32(len(mp.Signatures)))
+	for _, s := range mp.Signatures {
+		ser.WriteBytes(s)
+	}
+	ser.WriteBytes(mp.MessagePrefix)
+	ser.WriteBytes(mp.MessageInfo)
+	ser.Uleb128(uint32(len(mp.PublicKeys)))
+	for _, pk := range mp.PublicKeys {
+		ser.WriteBytes(pk)
+	}
+}
+
+func (mp *MultisignAuthPayload) UnmarshalBCS(des *bcs.Deserializer) {
+	slen := des.Uleb128()
+	mp.Signatures = make([][]byte, slen)
+	for i := range slen {
+		mp.Signatures[i] = des.ReadBytes()
+	}
+	mp.MessagePrefix = des.ReadBytes()
+	mp.MessageInfo = des.ReadBytes()
+	plen := des.Uleb128()
+	mp.PublicKeys = make([][]byte, plen)
+	for i := range plen {
+		mp.PublicKeys[i] = des.ReadBytes()
+	}
+}
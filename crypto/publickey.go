@@ -3,14 +3,17 @@
 
 package crypto
 
+import "github.com/rooch-network/rooch-go-sdk/address"
+
 // PublicKeyInitData represents data that can be converted into a public key
 // In Go, we'll typically handle this through constructor functions rather than a union type
 type PublicKeyInitData []byte
 
-// PublicKey interface defines the common behavior for public keys
-type PublicKey[T any] interface {
+// PublicKey interface defines the common behavior for public keys of every key scheme,
+// the concrete types also provide a typed ToAddress for their native address
+type PublicKey interface {
 	// Equals checks if two public keys are equal
-	Equals(publicKey PublicKey[T]) bool
+	Equals(publicKey PublicKey) bool
 
 	// ToBase64 returns the base-64 representation of the public key
 	ToBase64() string
@@ -24,8 +27,8 @@ type PublicKey[T any] interface {
 	// Flag returns signature scheme flag of the public key
 	Flag() uint8
 
-	// ToAddress converts the public key to its corresponding address
-	ToAddress() (*T, error)
+	// ToRoochAddress converts the public key to its corresponding Rooch address
+	ToRoochAddress() (*address.RoochAddress, error)
 
 	// Verify checks if the signature is valid for the provided message
 	Verify(data []byte, signature []byte) (bool, error)
@@ -1,6 +1,8 @@
 package crypto
 
 import (
+	"fmt"
+
 	"github.com/rooch-network/rooch-go-sdk/address"
 	"github.com/rooch-network/rooch-go-sdk/bcs"
 )
@@ -17,8 +19,8 @@ type Transaction interface {
 	bcs.Struct
 }
 
-// Signer a generic interface for any kind of signing
-type Signer[T any] interface {
+// Signer a common interface for any kind of signing, whatever the key scheme
+type Signer interface {
 	Sign(msg []byte) ([]byte, error)
 
 	SignTransaction(tx Transaction) (*Authenticator, error)
@@ -29,6 +31,27 @@ type Signer[T any] interface {
 
 	GetKeyScheme() SignatureScheme
 
-	//GetPublicKey() PublicKey[address.RoochAddress]
-	GetPublicKey() PublicKey[T]
+	GetPublicKey() PublicKey
+}
+
+// SignTransaction signs the transaction hash with the authenticator matching the key scheme of
+// signer, Ed25519 keys use the Rooch validator and Secp256k1 keys the Bitcoin validator
+func SignTransaction(tx Transaction, signer Signer) (*Authenticator, error) {
+	hash, err := tx.HashData()
+	if err != nil {
+		return nil, err
+	}
+
+	switch signer.GetKeyScheme() {
+	case Ed25519Scheme:
+		return RoochAuthValidator(hash, signer)
+	case Secp256k1Scheme:
+		info := ""
+		if tx.GetInfo() != nil {
+			info = *tx.GetInfo()
+		}
+		return BitcoinAuthValidator(NewBitcoinSignMessage(hash, info), signer, "hash")
+	default:
+		return nil, fmt.Errorf("unsupported key scheme %s", signer.GetKeyScheme())
+	}
 }
@@ -59,8 +59,27 @@ func GenerateEd25519Keypair() (*Ed25519Keypair, error) {
 	return NewEd25519Keypair(nil)
 }
 
-// FromSecretKey creates an Ed25519 keypair from a raw secret key byte array
-func FromEd25519SecretKey(secretKey []byte, skipValidation bool) (*Ed25519Keypair, error) {
+// FromSecretKey creates an Ed25519 keypair from a raw secret key byte array or a Bech32 encoded
+// roochsecretkey string
+func FromEd25519SecretKey(secretKey interface{}, skipValidation bool) (*Ed25519Keypair, error) {
+	switch v := secretKey.(type) {
+	case string:
+		parsedKeypair, err := crypto.DecodeRoochSecretKey(v)
+		if err != nil {
+			return nil, err
+		}
+		if parsedKeypair.Schema != crypto.Ed25519Scheme {
+			return nil, errors.New("provided secretKey is invalid")
+		}
+		return fromEd25519SecretKeyBytes(parsedKeypair.SecretKey, skipValidation)
+	case []byte:
+		return fromEd25519SecretKeyBytes(v, skipValidation)
+	default:
+		return nil, errors.New("invalid secretKey")
+	}
+}
+
+func fromEd25519SecretKeyBytes(secretKey []byte, skipValidation bool) (*Ed25519Keypair, error) {
 	if len(secretKey) != PrivateKeySize {
 		return nil, fmt.Errorf("wrong secretKey size. Expected %d bytes, got %d", PrivateKeySize, len(secretKey))
 	}
@@ -91,11 +110,11 @@ func (k *Ed25519Keypair) GetBitcoinAddress() (*address.BitcoinAddress, error) {
 
 // GetRoochAddress returns the Rooch address
 func (k *Ed25519Keypair) GetRoochAddress() (*address.RoochAddress, error) {
-	return k.GetPublicKey().ToAddress()
+	return k.GetPublicKey().ToRoochAddress()
 }
 
 // GetPublicKey returns the public key for this Ed25519 keypair
-func (k *Ed25519Keypair) GetPublicKey() crypto.PublicKey[address.RoochAddress] {
+func (k *Ed25519Keypair) GetPublicKey() crypto.PublicKey {
 	//return k.keypair.PublicKey
 	return &Ed25519PublicKey{k.keypair.PublicKey}
 }
@@ -140,6 +159,10 @@ func DeriveEd25519Keypair(mnemonics string, path string) (*Ed25519Keypair, error
 		return nil, errors.New("invalid derivation path")
 	}
 
+	if !bip39.IsMnemonicValid(mnemonics) {
+		return nil, errors.New("invalid mnemonic")
+	}
+
 	seed := bip39.NewSeed(mnemonics, "")
 	return deriveEd25519KeypairFromSeed(hex.EncodeToString(seed), path)
 }
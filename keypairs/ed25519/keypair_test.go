@@ -40,14 +40,13 @@ func TestEd25519Keypair(t *testing.T) {
 		keypair, _ := FromEd25519SecretKey(key.SecretKey, true)
 		assert.Equal(t, testCase.pk, keypair.GetPublicKey().ToBase64())
 
-		keypair1, _ := FromEd25519SecretKey([]byte(testCase.sk), true)
+		keypair1, _ := FromEd25519SecretKey(testCase.sk, true)
 		assert.Equal(t, testCase.pk, keypair1.GetPublicKey().ToBase64())
 	})
 
 	t.Run("Invalid mnemonics to derive ed25519 keypair", func(t *testing.T) {
-		assert.Panics(t, func() {
-			DeriveEd25519Keypair("rooch", "")
-		}, "Invalid mnemonic")
+		_, err := DeriveEd25519Keypair("rooch", "")
+		assert.EqualError(t, err, "invalid mnemonic")
 	})
 
 	t.Run("Recover ed25519 keypair by mnemonics", func(t *testing.T) {
@@ -70,3 +69,30 @@ func TestEd25519Keypair(t *testing.T) {
 		t.Skip("Not implemented yet")
 	})
 }
+
+func TestEd25519Address(t *testing.T) {
+	// The address is the blake2b-256 hash of the Ed25519 flag 0x00 followed by the public key
+	key, err := crypto.DecodeRoochSecretKey(testCases[0].sk)
+	assert.NoError(t, err)
+	keypair, err := FromEd25519SecretKey(key.SecretKey, true)
+	assert.NoError(t, err)
+	addr, err := keypair.GetRoochAddress()
+	assert.NoError(t, err)
+	assert.Equal(t, "0x198d8142dfe0e7416ae9296ced0b4822dcaa776b468192d05f8cb55c3df151ff", addr.StringLong())
+}
+
+func TestEd25519SecretKeyRoundTrip(t *testing.T) {
+	// The secret key exported by the rooch CLI decodes to the keypair and encodes back unchanged
+	testCase := testCases[0]
+	key, err := crypto.DecodeRoochSecretKey(testCase.sk)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.Ed25519Scheme, key.Schema)
+
+	keypair, err := FromEd25519SecretKey(key.SecretKey, false)
+	assert.NoError(t, err)
+	assert.Equal(t, testCase.pk, keypair.GetPublicKey().ToBase64())
+
+	secret, err := keypair.GetSecretKey()
+	assert.NoError(t, err)
+	assert.Equal(t, testCase.sk, secret)
+}
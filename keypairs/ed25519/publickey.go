@@ -46,7 +46,7 @@ func NewEd25519PublicKey(value interface{}) (*Ed25519PublicKey, error) {
 }
 
 // Equals checks if two Ed25519 public keys are equal
-func (pk *Ed25519PublicKey) Equals(other crypto.PublicKey[address.RoochAddress]) bool {
+func (pk *Ed25519PublicKey) Equals(other crypto.PublicKey) bool {
 	if pk == nil || other == nil {
 		return false
 	}
@@ -76,7 +76,7 @@ func (pk *Ed25519PublicKey) Verify(message, signature []byte) (bool, error) {
 // ToAddress returns the Rooch address associated with this Ed25519 public key
 func (pk *Ed25519PublicKey) ToAddress() (*address.RoochAddress, error) {
 	tmp := make([]byte, PublicKeySize+1)
-	tmp[0] = byte(crypto.SignatureSchemeSize[crypto.Ed25519Scheme])
+	tmp[0] = byte(crypto.Ed25519Flag)
 	//tmp.set([SIGNATURE_SCHEME_TO_FLAG.ED25519])
 	//copy(tmp[1:], pk.data)
 	copy(tmp[1:], pk.ToBytes())
@@ -85,10 +85,15 @@ func (pk *Ed25519PublicKey) ToAddress() (*address.RoochAddress, error) {
 	//addressBytes := hash[:address.ROOCH_ADDRESS_LENGTH*2]
 	//return address.NewRoochAddress(addressBytes)
 
-	addressBytes := utils.Blake2b256(tmp)[:address.RoochAddressLength*2]
+	addressBytes := utils.Blake2b256(tmp)[:address.RoochAddressLength]
 	return address.NewRoochAddressFromBytes(addressBytes)
 }
 
+// ToRoochAddress returns the Rooch address associated with this Ed25519 public key
+func (pk *Ed25519PublicKey) ToRoochAddress() (*address.RoochAddress, error) {
+	return pk.ToAddress()
+}
+
 func (pk *Ed25519PublicKey) ToBase64() string {
 	return utils.ToB64(pk.ToBytes())
 }
@@ -0,0 +1,274 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+// Package generator generates keypairs concurrently, either a batch of keys by index or the
+// keys whose Rooch address matches a pattern, and exports them to a keystore.
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/ed25519"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/rooch-network/rooch-go-sdk/keystore"
+)
+
+var (
+	ErrInvalidCount           = errors.New("count must be positive")
+	ErrUnsupportedScheme      = errors.New("unsupported signature scheme")
+	ErrDerivationNotSupported = errors.New("HD derivation is not supported for the scheme")
+)
+
+// Options configures a generation run
+type Options struct {
+	// Scheme is the signature scheme of the generated keys, Ed25519 when empty
+	Scheme crypto.SignatureScheme
+	// Count is the number of results to produce
+	Count int
+	// Workers is the number of goroutines, runtime.NumCPU() when zero
+	Workers int
+	// Pattern only keeps keys whose Rooch address matches, nil keeps every key
+	Pattern *Pattern
+	// Mnemonic derives the keys m/44'/784'/{index}'/0'/0' from StartIndex instead of generating random keys
+	Mnemonic string
+	// StartIndex is the first account index derived from Mnemonic
+	StartIndex uint32
+	// Network is the Bitcoin network of the Bitcoin addresses
+	Network address.BitcoinNetworkType
+}
+
+// Result is a generated key with its addresses
+type Result struct {
+	// Index is the derivation account index, or the order of the result for random keys
+	Index          uint32
+	Keypair        crypto.Signer
+	RoochAddress   *address.RoochAddress
+	BitcoinAddress *address.BitcoinAddress
+	// NostrAddress is only set for Secp256k1 keys
+	NostrAddress *address.NostrAddress
+	// SecretKey is the roochsecretkey1... encoding of the secret key
+	SecretKey      string
+	DerivationPath string
+}
+
+// DerivationPath returns the path of the account index used by the generator
+func DerivationPath(index uint32) string {
+	return fmt.Sprintf("m/44'/784'/%d'/0'/0'", index)
+}
+
+// Generate starts the workers and streams the results on the returned channel, the channel is
+// closed once Count results were sent, ctx is done, or a key failed to generate, in which case
+// the error is sent on the error channel. Without a Pattern the derived keys are exactly the indexes
+// StartIndex to StartIndex+Count-1, in the order the workers finish them
+func Generate(ctx context.Context, opts Options) (<-chan *Result, <-chan error, error) {
+	if opts.Count <= 0 {
+		return nil, nil, ErrInvalidCount
+	}
+	if opts.Scheme == "" {
+		opts.Scheme = crypto.Ed25519Scheme
+	}
+	if opts.Scheme != crypto.Ed25519Scheme && opts.Scheme != crypto.Secp256k1Scheme {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedScheme, opts.Scheme)
+	}
+	if opts.Mnemonic != "" && opts.Scheme != crypto.Ed25519Scheme {
+		return nil, nil, fmt.Errorf("%w: %s", ErrDerivationNotSupported, opts.Scheme)
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	var seedHex string
+	if opts.Mnemonic != "" {
+		seedHex = crypto.MnemonicToSeedHex(opts.Mnemonic)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	results := make(chan *Result)
+	errs := make(chan error, 1)
+	var (
+		next  atomic.Uint64
+		found atomic.Int64
+		sent  atomic.Int64
+		wg    sync.WaitGroup
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				attempt := next.Add(1) - 1
+				if seedHex != "" && opts.Pattern == nil && attempt >= uint64(opts.Count) {
+					// Every index of the batch is derived by another worker, deriving more indexes
+					// would race them for the Count results and leave gaps in the batch
+					return
+				}
+				var (
+					result *Result
+					err    error
+				)
+				if seedHex != "" {
+					index := opts.StartIndex + uint32(attempt)
+					result, err = deriveResult(seedHex, index)
+				} else {
+					result, err = randomResult(opts.Scheme, opts.Network)
+				}
+				if err != nil {
+					select {
+					case errs <- err:
+					default:
+					}
+					cancel()
+					return
+				}
+				if opts.Pattern != nil && !opts.Pattern.Match(result.RoochAddress) {
+					continue
+				}
+				n := found.Add(1)
+				if n > int64(opts.Count) {
+					return
+				}
+				if seedHex == "" {
+					result.Index = uint32(n - 1)
+				}
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+				if sent.Add(1) == int64(opts.Count) {
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+		close(results)
+		close(errs)
+	}()
+	return results, errs, nil
+}
+
+// Collect runs Generate and waits for all of its results, the results are ordered by index
+func Collect(ctx context.Context, opts Options) ([]*Result, error) {
+	results, errs, err := Generate(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	var collected []*Result
+	for result := range results {
+		collected = append(collected, result)
+	}
+	sort.Slice(collected, func(i, j int) bool {
+		return collected[i].Index < collected[j].Index
+	})
+	if err := <-errs; err != nil {
+		return collected, err
+	}
+	return collected, ctx.Err()
+}
+
+func randomResult(scheme crypto.SignatureScheme, network address.BitcoinNetworkType) (*Result, error) {
+	switch scheme {
+	case crypto.Secp256k1Scheme:
+		kp, err := secp256k1.GenerateSecp256k1Keypair()
+		if err != nil {
+			return nil, err
+		}
+		return secp256k1Result(kp, network)
+	default:
+		kp, err := ed25519.GenerateEd25519Keypair()
+		if err != nil {
+			return nil, err
+		}
+		return ed25519Result(kp)
+	}
+}
+
+func deriveResult(seedHex string, index uint32) (*Result, error) {
+	path := DerivationPath(index)
+	kp, err := ed25519.DeriveEd25519KeypairFromSeed(seedHex, path)
+	if err != nil {
+		return nil, err
+	}
+	result, err := ed25519Result(kp)
+	if err != nil {
+		return nil, err
+	}
+	result.Index = index
+	result.DerivationPath = path
+	return result, nil
+}
+
+func ed25519Result(kp *ed25519.Ed25519Keypair) (*Result, error) {
+	roochAddress, err := kp.GetRoochAddress()
+	if err != nil {
+		return nil, err
+	}
+	secretKey, err := kp.GetSecretKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		Keypair:      kp,
+		RoochAddress: roochAddress,
+		SecretKey:    secretKey,
+	}, nil
+}
+
+func secp256k1Result(kp *secp256k1.Secp256k1Keypair, network address.BitcoinNetworkType) (*Result, error) {
+	view, err := kp.GetSchnorrPublicKey().ToAddressWith(network)
+	if err != nil {
+		return nil, err
+	}
+	secretKey, err := crypto.EncodeRoochSecretKey(kp.GetSecretKey(), crypto.Secp256k1Scheme)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		Keypair:        kp,
+		RoochAddress:   &view.RoochAddress,
+		BitcoinAddress: &view.BitcoinAddress,
+		NostrAddress:   &view.NostrAddress,
+		SecretKey:      secretKey,
+	}, nil
+}
+
+// ToKeystoreEntry converts the result to a keystore entry
+func (r *Result) ToKeystoreEntry() *keystore.Entry {
+	entry := &keystore.Entry{
+		RoochAddress:   r.RoochAddress.StringFormat(address.AddressFormatBech32),
+		HexAddress:     r.RoochAddress.StringLong(),
+		PublicKey:      r.Keypair.GetPublicKey().String(),
+		SecretKey:      r.SecretKey,
+		DerivationPath: r.DerivationPath,
+	}
+	if r.BitcoinAddress != nil {
+		entry.BitcoinAddress = r.BitcoinAddress.String()
+	}
+	if r.NostrAddress != nil {
+		entry.NostrPublicKey = r.NostrAddress.String()
+	}
+	return entry
+}
+
+// ExportToKeystore adds the results to the keystore
+func ExportToKeystore(ks *keystore.Keystore, results []*Result) error {
+	for _, result := range results {
+		if err := ks.Add(result.ToKeystoreEntry()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
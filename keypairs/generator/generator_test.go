@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/ed25519"
+	"github.com/rooch-network/rooch-go-sdk/keystore"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestGenerate(t *testing.T) {
+	t.Run("batch of ed25519 keys", func(t *testing.T) {
+		results, err := Collect(context.Background(), Options{Count: 20, Workers: 4})
+		assert.NoError(t, err)
+		assert.Len(t, results, 20)
+		seen := make(map[address.RoochAddress]bool)
+		for _, result := range results {
+			assert.False(t, seen[*result.RoochAddress])
+			seen[*result.RoochAddress] = true
+			parsed, err := crypto.DecodeRoochSecretKey(result.SecretKey)
+			assert.NoError(t, err)
+			assert.Equal(t, crypto.Ed25519Scheme, parsed.Schema)
+		}
+	})
+
+	t.Run("secp256k1 keys have bitcoin and nostr addresses", func(t *testing.T) {
+		results, err := Collect(context.Background(), Options{
+			Scheme:  crypto.Secp256k1Scheme,
+			Count:   3,
+			Network: address.BitcoinNetworkTestnet,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, results, 3)
+		for _, result := range results {
+			assert.Equal(t, address.BitcoinNetworkTestnet, result.BitcoinAddress.Network())
+			assert.Contains(t, result.BitcoinAddress.String(), "tb1p")
+			assert.Contains(t, result.NostrAddress.String(), "npub1")
+			roochAddress, err := result.BitcoinAddress.GenRoochAddress()
+			assert.NoError(t, err)
+			assert.Equal(t, result.RoochAddress, roochAddress)
+		}
+	})
+
+	t.Run("derived keys match single derivation", func(t *testing.T) {
+		results, err := Collect(context.Background(), Options{Count: 5, Mnemonic: testMnemonic, StartIndex: 3})
+		assert.NoError(t, err)
+		assert.Len(t, results, 5)
+		indexes := make(map[uint32]bool)
+		for _, result := range results {
+			indexes[result.Index] = true
+			assert.Equal(t, DerivationPath(result.Index), result.DerivationPath)
+			kp, err := ed25519.DeriveEd25519Keypair(testMnemonic, result.DerivationPath)
+			assert.NoError(t, err)
+			roochAddress, _ := kp.GetRoochAddress()
+			assert.Equal(t, roochAddress, result.RoochAddress)
+		}
+		assert.Equal(t, map[uint32]bool{3: true, 4: true, 5: true, 6: true, 7: true}, indexes)
+	})
+
+	t.Run("derived batch with a worker per key", func(t *testing.T) {
+		// Repeat to give the workers a chance to finish out of order
+		for i := 0; i < 20; i++ {
+			results, err := Collect(context.Background(), Options{Count: 8, Workers: 8, Mnemonic: testMnemonic})
+			assert.NoError(t, err)
+			indexes := make([]uint32, len(results))
+			for j, result := range results {
+				indexes[j] = result.Index
+			}
+			assert.Equal(t, []uint32{0, 1, 2, 3, 4, 5, 6, 7}, indexes)
+		}
+	})
+
+	t.Run("vanity hex and bech32 patterns", func(t *testing.T) {
+		hexPattern, err := NewHexPattern("0xab")
+		assert.NoError(t, err)
+		results, err := Collect(context.Background(), Options{Count: 2, Pattern: hexPattern})
+		assert.NoError(t, err)
+		for _, result := range results {
+			assert.Contains(t, result.RoochAddress.StringLong(), "0xab")
+		}
+
+		bech32Pattern, err := NewBech32Pattern("rooch1qq")
+		assert.NoError(t, err)
+		results, err = Collect(context.Background(), Options{Count: 2, Pattern: bech32Pattern})
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		for _, result := range results {
+			assert.Contains(t, result.RoochAddress.StringFormat(address.AddressFormatBech32), "rooch1qq")
+		}
+	})
+
+	t.Run("cancellation stops the workers", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		// No address starts with 16 zero bytes in practice
+		pattern, _ := NewHexPattern("00000000000000000000000000000000")
+		results, errs, err := Generate(ctx, Options{Count: 1, Pattern: pattern})
+		assert.NoError(t, err)
+		cancel()
+		for range results {
+			t.Fatal("unexpected result")
+		}
+		assert.NoError(t, <-errs)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := Collect(context.Background(), Options{})
+		assert.ErrorIs(t, err, ErrInvalidCount)
+		_, err = Collect(context.Background(), Options{Count: 1, Scheme: crypto.Secp256k1Scheme, Mnemonic: testMnemonic})
+		assert.ErrorIs(t, err, ErrDerivationNotSupported)
+		_, err = NewHexPattern("0xzz")
+		assert.ErrorIs(t, err, ErrInvalidPattern)
+		_, err = NewBech32Pattern("rooch1b")
+		assert.ErrorIs(t, err, ErrInvalidPattern)
+	})
+}
+
+func TestExportToKeystore(t *testing.T) {
+	results, err := Collect(context.Background(), Options{Scheme: crypto.Secp256k1Scheme, Count: 4})
+	assert.NoError(t, err)
+
+	ks := keystore.NewKeystore()
+	assert.NoError(t, ExportToKeystore(ks, results))
+	assert.Equal(t, 4, ks.Len())
+	assert.ErrorIs(t, ExportToKeystore(ks, results[:1]), keystore.ErrDuplicateKey)
+
+	path := t.TempDir() + "/keystore.json"
+	assert.NoError(t, ks.Save(path))
+	loaded, err := keystore.LoadKeystore(path)
+	assert.NoError(t, err)
+	assert.Equal(t, ks.Entries(), loaded.Entries())
+
+	entry, err := loaded.Get(*results[0].RoochAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, results[0].BitcoinAddress.String(), entry.BitcoinAddress)
+	parsed, err := entry.Signer()
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.Secp256k1Scheme, parsed.Schema)
+}
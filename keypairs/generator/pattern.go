@@ -0,0 +1,67 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+)
+
+// bech32Charset is the alphabet of the data part of a bech32 string
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var ErrInvalidPattern = errors.New("invalid address pattern")
+
+// Pattern matches the beginning of a Rooch address, in either its hex or bech32 form
+type Pattern struct {
+	prefix string
+	bech32 bool
+}
+
+// NewHexPattern creates a Pattern matching the long hex form of the address after 0x
+func NewHexPattern(prefix string) (*Pattern, error) {
+	prefix = strings.TrimPrefix(strings.ToLower(prefix), "0x")
+	if len(prefix) == 0 || len(prefix) > 2*address.RoochAddressLength {
+		return nil, fmt.Errorf("%w: invalid hex prefix length %d", ErrInvalidPattern, len(prefix))
+	}
+	for _, c := range prefix {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return nil, fmt.Errorf("%w: %q is not a hex character", ErrInvalidPattern, c)
+		}
+	}
+	return &Pattern{prefix: prefix}, nil
+}
+
+// NewBech32Pattern creates a Pattern matching the bech32 form of the address after rooch1
+func NewBech32Pattern(prefix string) (*Pattern, error) {
+	prefix = strings.TrimPrefix(strings.ToLower(prefix), address.RoochBech32Prefix+"1")
+	if len(prefix) == 0 {
+		return nil, fmt.Errorf("%w: empty bech32 prefix", ErrInvalidPattern)
+	}
+	for _, c := range prefix {
+		if !strings.ContainsRune(bech32Charset, c) {
+			return nil, fmt.Errorf("%w: %q is not a bech32 character", ErrInvalidPattern, c)
+		}
+	}
+	return &Pattern{prefix: prefix, bech32: true}, nil
+}
+
+// Match checks if the address starts with the pattern
+func (p *Pattern) Match(roochAddress *address.RoochAddress) bool {
+	if p.bech32 {
+		return strings.HasPrefix(roochAddress.StringFormat(address.AddressFormatBech32), address.RoochBech32Prefix+"1"+p.prefix)
+	}
+	return strings.HasPrefix(roochAddress.StringLong()[2:], p.prefix)
+}
+
+// String returns the pattern as it is matched against addresses
+func (p *Pattern) String() string {
+	if p.bech32 {
+		return address.RoochBech32Prefix + "1" + p.prefix
+	}
+	return "0x" + p.prefix
+}
@@ -0,0 +1,180 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package remote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+const (
+	SignPath      = "/sign"
+	PublicKeyPath = "/public_key"
+)
+
+// signRequest is the body of a POST to [SignPath]
+type signRequest struct {
+	Message string `json:"message"` // Message is base64 encoded
+}
+
+// signResponse is the body returned from [SignPath]
+type signResponse struct {
+	Signature string `json:"signature"` // Signature is base64 encoded
+}
+
+// publicKeyResponse is the body returned from [PublicKeyPath]
+type publicKeyResponse struct {
+	PublicKey string `json:"public_key"` // PublicKey is base64 encoded
+}
+
+// errorResponse is returned with any non 200 status
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewHTTPHandler serves a [Backend] as a small signer daemon.
+//
+// The daemon exposes two endpoints:
+//   - POST [SignPath] with {"message": base64} returns {"signature": base64}
+//   - GET [PublicKeyPath] returns {"public_key": base64}
+func NewHTTPHandler(backend Backend) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(SignPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		var req signRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+			return
+		}
+		msg, err := utils.FromB64(req.Message)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid message encoding: %w", err))
+			return
+		}
+		signature, err := backend.Sign(msg)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, signResponse{Signature: utils.ToB64(signature)})
+	})
+	mux.HandleFunc(PublicKeyPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		publicKey, err := backend.PublicKey()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, publicKeyResponse{PublicKey: utils.ToB64(publicKey)})
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+// HTTPBackendOptions contains configuration for the HTTP backend
+type HTTPBackendOptions struct {
+	URL     string
+	Headers map[string]string
+	Timeout time.Duration
+}
+
+// HTTPBackend is a [Backend] talking to a signer daemon served by [NewHTTPHandler]
+type HTTPBackend struct {
+	options    HTTPBackendOptions
+	httpClient *http.Client
+}
+
+// NewHTTPBackend creates a new HTTPBackend instance
+func NewHTTPBackend(options HTTPBackendOptions) *HTTPBackend {
+	httpClient := &http.Client{}
+	httpClient.Timeout = 60 * time.Second
+	if options.Timeout != 0 {
+		httpClient.Timeout = options.Timeout
+	}
+	options.URL = strings.TrimSuffix(options.URL, "/")
+
+	return &HTTPBackend{
+		options:    options,
+		httpClient: httpClient,
+	}
+}
+
+// Sign asks the daemon to sign msg
+func (b *HTTPBackend) Sign(msg []byte) ([]byte, error) {
+	body, err := json.Marshal(signRequest{Message: utils.ToB64(msg)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	var resp signResponse
+	if err := b.do(http.MethodPost, SignPath, body, &resp); err != nil {
+		return nil, err
+	}
+	return utils.FromB64(resp.Signature)
+}
+
+// PublicKey asks the daemon for its public key
+func (b *HTTPBackend) PublicKey() ([]byte, error) {
+	var resp publicKeyResponse
+	if err := b.do(http.MethodGet, PublicKeyPath, nil, &resp); err != nil {
+		return nil, err
+	}
+	return utils.FromB64(resp.PublicKey)
+}
+
+func (b *HTTPBackend) do(method string, path string, body []byte, result interface{}) error {
+	req, err := http.NewRequest(method, b.options.URL+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range b.options.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp errorResponse
+		if json.Unmarshal(respBody, &errResp) == nil && errResp.Error != "" {
+			return fmt.Errorf("remote signer error: status=%d message=%s", resp.StatusCode, errResp.Error)
+		}
+		return fmt.Errorf("unexpected status code: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+// Package remote builds [crypto.Signer] implementations whose private key lives
+// outside the process, e.g. in a KMS, an HSM, a hardware wallet or a separate
+// signer daemon. The remote side only has to sign bytes and report its public
+// key; authenticator construction stays in the SDK.
+package remote
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/ed25519"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+)
+
+var (
+	ErrNilBackend          = errors.New("remote signer backend is nil")
+	ErrInvalidPublicKeyLen = errors.New("invalid remote public key length")
+)
+
+// Backend is the minimal surface a remote key holder has to provide.
+//
+// Sign must follow the same message convention as the local keypair of the
+// same scheme, i.e. Ed25519 signs the message as is and Secp256k1 signs the
+// SHA-256 digest of the message.
+type Backend interface {
+	// Sign returns the signature of msg
+	Sign(msg []byte) ([]byte, error)
+
+	// PublicKey returns the raw public key bytes of the remote key
+	PublicKey() ([]byte, error)
+}
+
+// BackendFuncs adapts a pair of callbacks into a [Backend]
+type BackendFuncs struct {
+	SignFunc      func(msg []byte) ([]byte, error)
+	PublicKeyFunc func() ([]byte, error)
+}
+
+// Sign calls SignFunc
+func (bf *BackendFuncs) Sign(msg []byte) ([]byte, error) {
+	if bf.SignFunc == nil {
+		return nil, errors.New("sign callback is not set")
+	}
+	return bf.SignFunc(msg)
+}
+
+// PublicKey calls PublicKeyFunc
+func (bf *BackendFuncs) PublicKey() ([]byte, error) {
+	if bf.PublicKeyFunc == nil {
+		return nil, errors.New("public key callback is not set")
+	}
+	return bf.PublicKeyFunc()
+}
+
+// FromEd25519Keypair exposes a local Ed25519 keypair as a [Backend], this is what a signer daemon wraps
+func FromEd25519Keypair(kp *ed25519.Ed25519Keypair) Backend {
+	return &BackendFuncs{
+		SignFunc: kp.Sign,
+		PublicKeyFunc: func() ([]byte, error) {
+			return kp.GetPublicKey().ToBytes(), nil
+		},
+	}
+}
+
+// FromSecp256k1Keypair exposes a local Secp256k1 keypair as a [Backend], this is what a signer daemon wraps
+func FromSecp256k1Keypair(kp *secp256k1.Secp256k1Keypair) Backend {
+	return &BackendFuncs{
+		SignFunc: kp.Sign,
+		PublicKeyFunc: func() ([]byte, error) {
+			return kp.GetPublicKey().ToBytes(), nil
+		},
+	}
+}
+
+// Signer is a [crypto.Signer] backed by a remote key, the authenticator is
+// picked from the key scheme like for local keypairs.
+type Signer struct {
+	backend   Backend
+	scheme    crypto.SignatureScheme
+	publicKey crypto.PublicKey
+}
+
+// NewSigner creates a signer for a remote key of the given scheme, a Secp256k1
+// backend must report the compressed public key
+func NewSigner(backend Backend, scheme crypto.SignatureScheme) (*Signer, error) {
+	if backend == nil {
+		return nil, ErrNilBackend
+	}
+	size, ok := crypto.SignatureSchemeSize[scheme]
+	if !ok {
+		return nil, fmt.Errorf("unsupported key scheme %s", scheme)
+	}
+	data, err := backend.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch remote public key: %w", err)
+	}
+	if len(data) != size {
+		return nil, ErrInvalidPublicKeyLen
+	}
+
+	var publicKey crypto.PublicKey
+	switch scheme {
+	case crypto.Ed25519Scheme:
+		publicKey, err = ed25519.NewEd25519PublicKey(data)
+	case crypto.Secp256k1Scheme:
+		publicKey, err = secp256k1.NewSecp256k1PublicKey(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		backend:   backend,
+		scheme:    scheme,
+		publicKey: publicKey,
+	}, nil
+}
+
+// NewEd25519Signer creates a signer for a remote Ed25519 key
+func NewEd25519Signer(backend Backend) (*Signer, error) {
+	return NewSigner(backend, crypto.Ed25519Scheme)
+}
+
+// NewSecp256k1Signer creates a signer for a remote Secp256k1 key
+func NewSecp256k1Signer(backend Backend) (*Signer, error) {
+	return NewSigner(backend, crypto.Secp256k1Scheme)
+}
+
+// Sign forwards the message to the backend
+func (s *Signer) Sign(msg []byte) ([]byte, error) {
+	return s.backend.Sign(msg)
+}
+
+// GetKeyScheme returns the key scheme of the remote key
+func (s *Signer) GetKeyScheme() crypto.SignatureScheme {
+	return s.scheme
+}
+
+// GetPublicKey returns the public key of the remote key
+func (s *Signer) GetPublicKey() crypto.PublicKey {
+	return s.publicKey
+}
+
+// GetRoochAddress returns the Rooch address of the remote key
+func (s *Signer) GetRoochAddress() (*address.RoochAddress, error) {
+	return s.publicKey.ToRoochAddress()
+}
+
+// GetBitcoinAddress returns the Bitcoin address of the remote key, only Secp256k1 keys have one
+func (s *Signer) GetBitcoinAddress() (*address.BitcoinAddress, error) {
+	pk, ok := s.publicKey.(*secp256k1.Secp256k1PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("method not implemented in %s", s.scheme)
+	}
+	view, err := pk.ToAddress()
+	if err != nil {
+		return nil, err
+	}
+	return &view.BitcoinAddress, nil
+}
+
+// SignTransaction signs the transaction hash remotely and builds the authenticator locally
+func (s *Signer) SignTransaction(tx crypto.Transaction) (*crypto.Authenticator, error) {
+	return crypto.SignTransaction(tx, s)
+}
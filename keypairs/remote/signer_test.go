@@ -0,0 +1,152 @@
+package remote
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/ed25519"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTransaction() *types.Transaction {
+	return &types.Transaction{
+		Data: types.TransactionData{
+			Sender:         types.AddressOne,
+			SequenceNumber: 1,
+			ChainId:        4,
+			MaxGasAmount:   100000000,
+			Action: types.MoveAction{Action: &types.FunctionCall{
+				FunctionId: types.FunctionId{
+					ModuleId:     types.ModuleId{Address: types.AddressThree, Name: "empty"},
+					FunctionName: "empty",
+				},
+				TypeArgs: []types.TypeTag{},
+				Args:     [][]byte{},
+			}},
+		},
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Run("Ed25519 over HTTP daemon", func(t *testing.T) {
+		kp, err := ed25519.GenerateEd25519Keypair()
+		assert.NoError(t, err)
+		server := httptest.NewServer(NewHTTPHandler(FromEd25519Keypair(kp)))
+		defer server.Close()
+
+		signer, err := NewEd25519Signer(NewHTTPBackend(HTTPBackendOptions{URL: server.URL}))
+		assert.NoError(t, err)
+		assert.Equal(t, crypto.Ed25519Scheme, signer.GetKeyScheme())
+		assert.True(t, signer.GetPublicKey().Equals(kp.GetPublicKey()))
+
+		expectAddr, _ := kp.GetRoochAddress()
+		addr, err := signer.GetRoochAddress()
+		assert.NoError(t, err)
+		assert.Equal(t, expectAddr.String(), addr.String())
+
+		msg := []byte("hello rooch")
+		signature, err := signer.Sign(msg)
+		assert.NoError(t, err)
+		valid, _ := kp.GetPublicKey().Verify(msg, signature)
+		assert.True(t, valid)
+
+		// Ed25519 is deterministic, so the remote and local authenticators must match
+		tx := newTestTransaction()
+		remoteAuth, err := signer.SignTransaction(tx)
+		assert.NoError(t, err)
+		localAuth, err := kp.SignTransaction(tx)
+		assert.NoError(t, err)
+		assert.Equal(t, localAuth, remoteAuth)
+	})
+
+	t.Run("Secp256k1 over HTTP daemon", func(t *testing.T) {
+		kp, err := secp256k1.GenerateSecp256k1Keypair()
+		assert.NoError(t, err)
+		server := httptest.NewServer(NewHTTPHandler(FromSecp256k1Keypair(kp)))
+		defer server.Close()
+
+		signer, err := NewSecp256k1Signer(NewHTTPBackend(HTTPBackendOptions{URL: server.URL}))
+		assert.NoError(t, err)
+		assert.Equal(t, crypto.Secp256k1Scheme, signer.GetKeyScheme())
+
+		msg := []byte("hello rooch")
+		signature, err := signer.Sign(msg)
+		assert.NoError(t, err)
+		valid, _ := kp.GetPublicKey().Verify(msg, signature)
+		assert.True(t, valid)
+
+		expectAddr, _ := kp.GetBitcoinAddress()
+		addr, err := signer.GetBitcoinAddress()
+		assert.NoError(t, err)
+		assert.Equal(t, expectAddr.ToBytes(), addr.ToBytes())
+
+		auth, err := signer.SignTransaction(newTestTransaction())
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(crypto.AuthValidatorTypeBitcoin), auth.AuthValidatorId)
+	})
+
+	t.Run("Callback backend", func(t *testing.T) {
+		kp, _ := ed25519.GenerateEd25519Keypair()
+		calls := 0
+		signer, err := NewEd25519Signer(&BackendFuncs{
+			SignFunc: func(msg []byte) ([]byte, error) {
+				calls++
+				return kp.Sign(msg)
+			},
+			PublicKeyFunc: func() ([]byte, error) {
+				return kp.GetPublicKey().ToBytes(), nil
+			},
+		})
+		assert.NoError(t, err)
+		_, err = signer.Sign([]byte{0x01})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Backend errors are surfaced", func(t *testing.T) {
+		server := httptest.NewServer(NewHTTPHandler(&BackendFuncs{
+			SignFunc: func(msg []byte) ([]byte, error) {
+				return nil, errors.New("device locked")
+			},
+			PublicKeyFunc: func() ([]byte, error) {
+				return make([]byte, 32), nil
+			},
+		}))
+		defer server.Close()
+
+		signer, err := NewEd25519Signer(NewHTTPBackend(HTTPBackendOptions{URL: server.URL}))
+		assert.NoError(t, err)
+		_, err = signer.Sign([]byte{0x01})
+		assert.ErrorContains(t, err, "device locked")
+	})
+
+	t.Run("Interchangeable signers", func(t *testing.T) {
+		edKp, _ := ed25519.GenerateEd25519Keypair()
+		k1Kp, _ := secp256k1.GenerateSecp256k1Keypair()
+		edSigner, _ := NewEd25519Signer(FromEd25519Keypair(edKp))
+		k1Signer, _ := NewSecp256k1Signer(FromSecp256k1Keypair(k1Kp))
+
+		expect := map[crypto.SignatureScheme]uint64{
+			crypto.Ed25519Scheme:   uint64(crypto.AuthValidatorTypeRooch),
+			crypto.Secp256k1Scheme: uint64(crypto.AuthValidatorTypeBitcoin),
+		}
+		for _, signer := range []crypto.Signer{edKp, k1Kp, edSigner, k1Signer} {
+			auth, err := crypto.SignTransaction(newTestTransaction(), signer)
+			assert.NoError(t, err)
+			assert.Equal(t, expect[signer.GetKeyScheme()], auth.AuthValidatorId)
+		}
+	})
+
+	t.Run("Wrong public key size", func(t *testing.T) {
+		_, err := NewSecp256k1Signer(&BackendFuncs{
+			PublicKeyFunc: func() ([]byte, error) {
+				return make([]byte, 32), nil
+			},
+		})
+		assert.ErrorIs(t, err, ErrInvalidPublicKeyLen)
+	})
+}
@@ -0,0 +1,53 @@
+package secp256k1
+
+import (
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEthereum(t *testing.T) {
+	secretKey, _ := utils.HexToBytes("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	expectEthAddress := "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+
+	t.Run("Ethereum address", func(t *testing.T) {
+		kp, err := FromSecp256k1SecretKey(secretKey, false)
+		assert.NoError(t, err)
+		ethAddr, err := kp.GetEthereumAddress()
+		assert.NoError(t, err)
+		assert.Equal(t, expectEthAddress, ethAddr.String())
+
+		parsed, err := address.NewEthereumAddress("0x2c7536e3605d9c16a7a3d7b1898e529396a65c23")
+		assert.NoError(t, err)
+		assert.Equal(t, ethAddr.ToBytes(), parsed.ToBytes())
+		_, err = address.NewEthereumAddress("0x2C7536E3605D9C16a7a3D7b1898e529396a65c23")
+		assert.Error(t, err)
+
+		roochAddr, err := ethAddr.GenRoochAddress()
+		assert.NoError(t, err)
+		assert.Equal(t, utils.Blake2b256(ethAddr.ToBytes()), roochAddr.Bytes())
+	})
+
+	t.Run("Ethereum authenticator", func(t *testing.T) {
+		kp, _ := FromSecp256k1SecretKey(secretKey, false)
+		txHash := utils.Sha3256([]byte("rooch transaction"))
+
+		auth, err := crypto.EthereumAuthValidator(txHash, kp)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(crypto.AuthValidatorTypeEthereum), auth.AuthValidatorId)
+
+		payload := crypto.EthereumAuthPayload{}
+		assert.NoError(t, bcs.Deserialize(&payload, auth.Payload))
+		assert.Equal(t, 65, len(payload.Signature))
+		assert.Contains(t, []byte{27, 28}, payload.Signature[64])
+
+		recovered, err := crypto.RecoverEthereumAddress(txHash, payload.Signature)
+		assert.NoError(t, err)
+		assert.Equal(t, expectEthAddress, recovered.String())
+		assert.Equal(t, recovered.ToBytes(), payload.FromAddress)
+	})
+}
@@ -104,7 +104,8 @@ func FromSecp256k1SecretKey(secretKey interface{}, skipValidation bool) (*Secp25
 			return nil, err
 		}
 
-		if !secp256k1.VerifySignature(pubKey, msgHash, signature) {
+		// VerifySignature takes the signature without the recovery id
+		if !secp256k1.VerifySignature(pubKey, msgHash, signature[:64]) {
 			return nil, errors.New("provided secretKey is invalid")
 		}
 	}
@@ -134,17 +135,41 @@ func FromSecp256k1Seed(seed []byte) (*Secp256k1Keypair, error) {
 //	func (kp *Secp256k1Keypair) GetPublicKey() []byte {
 //		return kp.keypair.PublicKey
 //	}
-func (kp *Secp256k1Keypair) GetPublicKey() crypto.PublicKey[address.AddressView] {
+func (kp *Secp256k1Keypair) GetPublicKey() crypto.PublicKey {
 	return &Secp256k1PublicKey{kp.keypair.PublicKey}
 }
 
 // GetSchnorrPublicKey returns the Schnorr public key
-func (kp *Secp256k1Keypair) GetSchnorrPublicKey() crypto.PublicKey[address.AddressView] {
+func (kp *Secp256k1Keypair) GetSchnorrPublicKey() *Secp256k1PublicKey {
 	privateKey, _ := btcec.PrivKeyFromBytes(kp.keypair.SecretKey)
 	schnorrPubKey := schnorr.SerializePubKey(privateKey.PubKey())
 	return &Secp256k1PublicKey{schnorrPubKey}
 }
 
+// GetKeyScheme returns the signature scheme for Secp256k1
+func (kp *Secp256k1Keypair) GetKeyScheme() crypto.SignatureScheme {
+	return crypto.Secp256k1Scheme
+}
+
+// GetRoochAddress returns the Rooch address
+func (kp *Secp256k1Keypair) GetRoochAddress() (*address.RoochAddress, error) {
+	return kp.GetPublicKey().ToRoochAddress()
+}
+
+// GetBitcoinAddress returns the Bitcoin address
+func (kp *Secp256k1Keypair) GetBitcoinAddress() (*address.BitcoinAddress, error) {
+	view, err := kp.GetSchnorrPublicKey().ToAddress()
+	if err != nil {
+		return nil, err
+	}
+	return &view.BitcoinAddress, nil
+}
+
+// SignTransaction signs a transaction with the Bitcoin authenticator
+func (kp *Secp256k1Keypair) SignTransaction(tx crypto.Transaction) (*crypto.Authenticator, error) {
+	return crypto.SignTransaction(tx, kp)
+}
+
 // GetSecretKey returns the secret key
 func (kp *Secp256k1Keypair) GetSecretKey() []byte {
 	return kp.keypair.SecretKey
@@ -160,7 +185,27 @@ func (kp *Secp256k1Keypair) Sign(input []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return signature, nil
+	// Drop the recovery id, Rooch expects the 64 bytes compact signature
+	return signature[:64], nil
+}
+
+// SignRecoverable signs a prehashed digest and returns the 65 bytes signature with the recovery id
+func (kp *Secp256k1Keypair) SignRecoverable(digest []byte) ([]byte, error) {
+	return secp256k1.Sign(digest, kp.GetSecretKey())
+}
+
+// GetEthereumAddress returns the Ethereum address
+func (kp *Secp256k1Keypair) GetEthereumAddress() (*address.EthereumAddress, error) {
+	return address.EthereumAddressFromPublicKey(kp.keypair.PublicKey)
+}
+
+// SignEthereumTransaction signs a transaction with the Ethereum authenticator
+func (kp *Secp256k1Keypair) SignEthereumTransaction(tx crypto.Transaction) (*crypto.Authenticator, error) {
+	hash, err := tx.HashData()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.EthereumAuthValidator(hash, kp)
 }
 
 // DeriveKeypair derives a keypair from mnemonics and path
@@ -2,6 +2,7 @@ package secp256k1
 
 import (
 	_ "encoding/base64"
+	"github.com/rooch-network/rooch-go-sdk/address"
 	"github.com/rooch-network/rooch-go-sdk/crypto"
 	"strings"
 	"testing"
@@ -27,8 +28,9 @@ func TestSecp256k1Keypair(t *testing.T) {
 
 	t.Run("Export secp256k1 keypair", func(t *testing.T) {
 		kp, _ := GenerateSecp256k1Keypair()
-		secret := kp.GetSecretKey()
-		assert.True(t, strings.HasPrefix(string(secret), crypto.RoochSecretKeyPrefix))
+		secret, err := crypto.EncodeRoochSecretKey(kp.GetSecretKey(), crypto.Secp256k1Scheme)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(secret, crypto.RoochSecretKeyPrefix))
 	})
 
 	t.Run("Create secp256k1 keypair from CLI secret key", func(t *testing.T) {
@@ -36,16 +38,19 @@ func TestSecp256k1Keypair(t *testing.T) {
 		expectRoochHexAddress := "0xf892b3fd5fd0e93436ba3dc8d504413769d66901266143d00e49441079243ed0"
 		expectRoochBech32Address := "rooch1lzft8l2l6r5ngd468hyd2pzpxa5av6gpyes585qwf9zpq7fy8mgqh9npj5"
 		expectNostrAddress := "npub1h54r2zvulk96qjmfnyy83mtry0pp5acnz6uvk637typxtvn90c8s0lrc0g"
-		//expectBitcoinAddress := "bcrt1pw9l5h7vepq8cnpugwm848x3at34gg5eq0mamdrjw0krunfjm0zfq65gjzz"
+		expectBitcoinAddress := "bcrt1pw9l5h7vepq8cnpugwm848x3at34gg5eq0mamdrjw0krunfjm0zfq65gjzz"
 
 		sk, _ := FromSecp256k1SecretKey(testKey, false)
-		addrView, _ := sk.GetSchnorrPublicKey().ToAddress()
+		addrView, _ := sk.GetSchnorrPublicKey().ToAddressWith(address.BitcoinNetworkRegtest)
 
 		bench32Addr, _ := addrView.RoochAddress.ToBech32()
 		assert.Equal(t, expectRoochHexAddress, addrView.RoochAddress.String())
 		assert.Equal(t, expectRoochBech32Address, bench32Addr)
 		assert.Equal(t, expectNostrAddress, addrView.NostrAddress.ToStr())
-		//assert.Equal(t, expectBitcoinAddress, addrView.BitcoinAddress.String())
+		assert.Equal(t, expectBitcoinAddress, addrView.BitcoinAddress.String())
+
+		compressedView, _ := sk.GetPublicKey().(*Secp256k1PublicKey).ToAddressWith(address.BitcoinNetworkRegtest)
+		assert.Equal(t, expectBitcoinAddress, compressedView.BitcoinAddress.String())
 	})
 
 	t.Run("Create secp256k1 keypair from secret key", func(t *testing.T) {
@@ -78,3 +83,16 @@ func TestSecp256k1Keypair(t *testing.T) {
 		})
 	})
 }
+
+func TestSecp256k1Sign(t *testing.T) {
+	keypair, err := GenerateSecp256k1Keypair()
+	assert.NoError(t, err)
+	message := []byte("hello rooch")
+	signature, err := keypair.Sign(message)
+	assert.NoError(t, err)
+	// The compact signature without the recovery id
+	assert.Len(t, signature, 64)
+	valid, err := keypair.GetPublicKey().Verify(message, signature)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
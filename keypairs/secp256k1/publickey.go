@@ -47,7 +47,7 @@ func NewSecp256k1PublicKey(value interface{}) (*Secp256k1PublicKey, error) {
 }
 
 // Equals checks if two Secp256k1 public keys are equal
-func (pk *Secp256k1PublicKey) Equals(other crypto.PublicKey[address.AddressView]) bool {
+func (pk *Secp256k1PublicKey) Equals(other crypto.PublicKey) bool {
 	//return crypto.BytesEqual(pk.data, other.data)
 	if pk == nil || other == nil {
 		return false
@@ -69,17 +69,32 @@ func (pk *Secp256k1PublicKey) ToBase64() string {
 	return utils.ToB64(pk.ToBytes())
 }
 
-// ToAddress returns the Bitcoin address associated with this Secp256k1 public key
-// func (pk *Secp256k1PublicKey) ToAddress() (*address.AddressView, error) {
+// ToAddress returns the addresses associated with this Secp256k1 public key on Bitcoin regtest, use
+// [Secp256k1PublicKey.ToAddressWith] for the addresses on another network
 func (pk *Secp256k1PublicKey) ToAddress() (*address.AddressView, error) {
 	return address.NewAddressView(pk.data)
 }
 
-// ToAddressWith returns the Bitcoin address with specified network type
+// ToAddressWith returns the addresses associated with this Secp256k1 public key on the specified network
 func (pk *Secp256k1PublicKey) ToAddressWith(network address.BitcoinNetworkType) (*address.AddressView, error) {
 	return address.NewAddressViewWithNetwork(pk.data, network)
 }
 
+// ToBitcoinAddress returns the Bitcoin address of the given payment type, only P2TR is
+// available for an x-only public key
+func (pk *Secp256k1PublicKey) ToBitcoinAddress(paymentType address.BitcoinPaymentType, network address.BitcoinNetworkType) (*address.BitcoinAddress, error) {
+	return address.BitcoinAddressFromPublicKeyWithType(pk.data, paymentType, network)
+}
+
+// ToRoochAddress returns the Rooch address associated with this Secp256k1 public key
+func (pk *Secp256k1PublicKey) ToRoochAddress() (*address.RoochAddress, error) {
+	view, err := pk.ToAddress()
+	if err != nil {
+		return nil, err
+	}
+	return &view.RoochAddress, nil
+}
+
 // Flag returns the signature scheme flag for Secp256k1
 func (pk *Secp256k1PublicKey) Flag() uint8 {
 	return uint8(crypto.Secp256k1Flag)
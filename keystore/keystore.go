@@ -0,0 +1,155 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+// Package keystore implements a file backed store of account keys, indexed by
+// Rooch address, with the addresses of every chain the key maps to.
+//
+// Secret keys are stored unencrypted, anyone who can read the keystore file can
+// sign with its keys. Keep the file private or encrypt it at rest.
+package keystore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+)
+
+var (
+	ErrDuplicateKey = errors.New("key already in keystore")
+	ErrKeyNotFound  = errors.New("key not found in keystore")
+)
+
+// Entry is a stored key, SecretKey is the roochsecretkey1... encoding and is stored unencrypted
+type Entry struct {
+	RoochAddress   string `json:"rooch_address"`
+	HexAddress     string `json:"hex_address"`
+	BitcoinAddress string `json:"bitcoin_address,omitempty"`
+	NostrPublicKey string `json:"nostr_public_key,omitempty"`
+	PublicKey      string `json:"public_key"`
+	SecretKey      string `json:"secret_key"`
+	DerivationPath string `json:"derivation_path,omitempty"`
+}
+
+// Signer decodes the secret key of the entry into its scheme and raw secret key
+func (e *Entry) Signer() (*crypto.ParsedKeypair, error) {
+	return crypto.DecodeRoochSecretKey(e.SecretKey)
+}
+
+// Keystore is a set of entries indexed by their Rooch address, safe for concurrent use
+type Keystore struct {
+	mu   sync.RWMutex
+	keys map[address.RoochAddress]*Entry
+}
+
+// NewKeystore creates an empty Keystore
+func NewKeystore() *Keystore {
+	return &Keystore{keys: make(map[address.RoochAddress]*Entry)}
+}
+
+// Add stores the entry, an address can only be stored once
+func (ks *Keystore) Add(entry *Entry) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	return addEntry(ks.keys, entry)
+}
+
+// addEntry stores the entry in keys, the caller holds the lock of keys
+func addEntry(keys map[address.RoochAddress]*Entry, entry *Entry) error {
+	roochAddress, err := address.ParseRoochAddress(entry.HexAddress)
+	if err != nil {
+		return err
+	}
+	if _, ok := keys[*roochAddress]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateKey, entry.RoochAddress)
+	}
+	keys[*roochAddress] = entry
+	return nil
+}
+
+// Get returns the entry of the Rooch address
+func (ks *Keystore) Get(roochAddress address.RoochAddress) (*Entry, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	entry, ok := ks.keys[roochAddress]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, roochAddress.StringLong())
+	}
+	return entry, nil
+}
+
+// Len returns the number of stored entries
+func (ks *Keystore) Len() int {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return len(ks.keys)
+}
+
+// Entries returns the stored entries ordered by Rooch address
+func (ks *Keystore) Entries() []*Entry {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	entries := make([]*Entry, 0, len(ks.keys))
+	for _, entry := range ks.keys {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].HexAddress < entries[j].HexAddress
+	})
+	return entries
+}
+
+type keystoreFile struct {
+	Keys []*Entry `json:"keys"`
+}
+
+// MarshalJSON converts the Keystore to JSON
+func (ks *Keystore) MarshalJSON() ([]byte, error) {
+	return json.Marshal(keystoreFile{Keys: ks.Entries()})
+}
+
+// UnmarshalJSON converts the Keystore from JSON, the stored entries are replaced and are kept on error
+func (ks *Keystore) UnmarshalJSON(b []byte) error {
+	var file keystoreFile
+	if err := json.Unmarshal(b, &file); err != nil {
+		return fmt.Errorf("failed to convert input to Keystore: %w", err)
+	}
+	keys := make(map[address.RoochAddress]*Entry, len(file.Keys))
+	for _, entry := range file.Keys {
+		if err := addEntry(keys, entry); err != nil {
+			return err
+		}
+	}
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.keys = keys
+	return nil
+}
+
+// Save writes the keystore to path, readable by the owner only. The secret keys are written
+// unencrypted, see the package documentation
+func (ks *Keystore) Save(path string) error {
+	data, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// LoadKeystore reads a keystore written by [Keystore.Save]
+func LoadKeystore(path string) (*Keystore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ks := NewKeystore()
+	if err := json.Unmarshal(data, ks); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
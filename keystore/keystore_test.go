@@ -0,0 +1,58 @@
+package keystore
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/stretchr/testify/assert"
+)
+
+func testEntry(b string) *Entry {
+	hexAddress := "0x" + strings.Repeat(b, address.RoochAddressLength)
+	return &Entry{RoochAddress: hexAddress, HexAddress: hexAddress}
+}
+
+func TestKeystoreUnmarshalJSON(t *testing.T) {
+	ks := NewKeystore()
+	assert.NoError(t, ks.Add(testEntry("01")))
+
+	data, err := json.Marshal(keystoreFile{Keys: []*Entry{testEntry("02"), testEntry("03")}})
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(data, ks))
+	// The loaded entries replace the stored ones
+	assert.Equal(t, []*Entry{testEntry("02"), testEntry("03")}, ks.Entries())
+
+	// A duplicate entry fails and keeps the stored entries
+	duplicate, err := json.Marshal(keystoreFile{Keys: []*Entry{testEntry("04"), testEntry("04")}})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, json.Unmarshal(duplicate, ks), ErrDuplicateKey)
+	assert.Equal(t, 2, ks.Len())
+}
+
+func TestKeystoreConcurrentUnmarshalJSON(t *testing.T) {
+	ks := NewKeystore()
+	data, err := json.Marshal(keystoreFile{Keys: []*Entry{testEntry("02")}})
+	assert.NoError(t, err)
+	roochAddress, err := address.ParseRoochAddress(testEntry("02").HexAddress)
+	assert.NoError(t, err)
+
+	// Run with -race, loading the keystore while reading it is safe
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, json.Unmarshal(data, ks))
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = ks.Get(*roochAddress)
+			ks.Entries()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, ks.Len())
+}
@@ -0,0 +1,116 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+// Package multisign implements Rooch Bitcoin multisign accounts: address
+// derivation, the account creation call and the collection of partial
+// signatures into the on-chain multisign authenticator.
+package multisign
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/rooch-network/rooch-go-sdk/address"
+)
+
+const CompressedPublicKeySize = 33
+
+var (
+	ErrInvalidThreshold   = errors.New("invalid multisign threshold")
+	ErrInvalidPublicKey   = errors.New("invalid multisign public key")
+	ErrDuplicatePublicKey = errors.New("duplicate multisign public key")
+)
+
+// SortPublicKeys validates the compressed public keys and returns a copy sorted by their
+// x-only form, this is the canonical order used on chain
+func SortPublicKeys(publicKeys [][]byte) ([][]byte, error) {
+	sorted := make([][]byte, len(publicKeys))
+	for i, pk := range publicKeys {
+		if len(pk) != CompressedPublicKeySize {
+			return nil, fmt.Errorf("%w: want %d bytes, have %d", ErrInvalidPublicKey, CompressedPublicKeySize, len(pk))
+		}
+		if _, err := btcec.ParsePubKey(pk); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+		}
+		sorted[i] = pk
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][1:], sorted[j][1:]) < 0
+	})
+	for i := 1; i < len(sorted); i++ {
+		if bytes.Equal(sorted[i-1][1:], sorted[i][1:]) {
+			return nil, ErrDuplicatePublicKey
+		}
+	}
+	return sorted, nil
+}
+
+func checkThreshold(threshold uint64, keys int) error {
+	if threshold == 0 || threshold > uint64(keys) {
+		return fmt.Errorf("%w: %d of %d", ErrInvalidThreshold, threshold, keys)
+	}
+	return nil
+}
+
+// CreateMultisigScript builds the tapscript leaf
+//
+//	<pk_1> OP_CHECKSIG <pk_2> OP_CHECKSIGADD ... <pk_n> OP_CHECKSIGADD <threshold> OP_NUMEQUAL
+//
+// over the x-only form of the given public keys, which must be sorted
+func CreateMultisigScript(threshold uint64, sortedPublicKeys [][]byte) ([]byte, error) {
+	if err := checkThreshold(threshold, len(sortedPublicKeys)); err != nil {
+		return nil, err
+	}
+	builder := txscript.NewScriptBuilder()
+	for i, pk := range sortedPublicKeys {
+		builder.AddData(pk[1:])
+		if i == 0 {
+			builder.AddOp(txscript.OP_CHECKSIG)
+		} else {
+			builder.AddOp(txscript.OP_CHECKSIGADD)
+		}
+	}
+	builder.AddInt64(int64(threshold))
+	builder.AddOp(txscript.OP_NUMEQUAL)
+	return builder.Script()
+}
+
+// GenerateMultisignAddress derives the taproot address of a multisign account.
+//
+// The output key commits to a single multisig leaf, the internal key is the first
+// public key in canonical order.
+func GenerateMultisignAddress(threshold uint64, publicKeys [][]byte, network address.BitcoinNetworkType) (*address.BitcoinAddress, error) {
+	sorted, err := SortPublicKeys(publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	script, err := CreateMultisigScript(threshold, sorted)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := txscript.AssembleTaprootScriptTree(txscript.NewBaseTapLeaf(script))
+	merkleRoot := tree.RootNode.TapHash()
+
+	internalKey, err := schnorr.ParsePubKey(sorted[0][1:])
+	if err != nil {
+		return nil, err
+	}
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, merkleRoot[:])
+
+	return address.BitcoinTaprootAddressFromOutputKey(schnorr.SerializePubKey(outputKey), network)
+}
+
+// GenerateMultisignRoochAddress derives the Rooch address of a multisign account
+func GenerateMultisignRoochAddress(threshold uint64, publicKeys [][]byte) (*address.RoochAddress, error) {
+	bitcoinAddress, err := GenerateMultisignAddress(threshold, publicKeys, address.BitcoinNetworkBitcoin)
+	if err != nil {
+		return nil, err
+	}
+	return bitcoinAddress.GenRoochAddress()
+}
@@ -0,0 +1,121 @@
+package multisign
+
+import (
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKeypairs(t *testing.T, n int) ([]*secp256k1.Secp256k1Keypair, [][]byte) {
+	keypairs := make([]*secp256k1.Secp256k1Keypair, n)
+	publicKeys := make([][]byte, n)
+	for i := range n {
+		kp, err := secp256k1.GenerateSecp256k1Keypair()
+		assert.NoError(t, err)
+		keypairs[i] = kp
+		publicKeys[i] = kp.GetPublicKey().ToBytes()
+	}
+	return keypairs, publicKeys
+}
+
+func newTestTransactionData(t *testing.T, threshold uint64, publicKeys [][]byte) types.TransactionData {
+	sender, err := GenerateMultisignRoochAddress(threshold, publicKeys)
+	assert.NoError(t, err)
+	call, err := NewCreateMultisignAccountCall(threshold, publicKeys)
+	assert.NoError(t, err)
+	return types.TransactionData{
+		Sender:         *sender,
+		SequenceNumber: 0,
+		ChainId:        4,
+		MaxGasAmount:   100000000,
+		Action:         types.MoveAction{Action: call},
+	}
+}
+
+func TestMultisignAddress(t *testing.T) {
+	t.Run("Independent of key order", func(t *testing.T) {
+		_, publicKeys := newTestKeypairs(t, 3)
+		a, err := GenerateMultisignAddress(2, publicKeys, address.BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		reversed := [][]byte{publicKeys[2], publicKeys[1], publicKeys[0]}
+		b, err := GenerateMultisignAddress(2, reversed, address.BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.Equal(t, a.ToBytes(), b.ToBytes())
+		assert.Contains(t, string(a.ToBytes()), "bc1p")
+
+		c, err := GenerateMultisignAddress(3, publicKeys, address.BitcoinNetworkBitcoin)
+		assert.NoError(t, err)
+		assert.NotEqual(t, a.ToBytes(), c.ToBytes())
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		_, publicKeys := newTestKeypairs(t, 2)
+		_, err := GenerateMultisignAddress(3, publicKeys, address.BitcoinNetworkBitcoin)
+		assert.ErrorIs(t, err, ErrInvalidThreshold)
+		_, err = GenerateMultisignAddress(0, publicKeys, address.BitcoinNetworkBitcoin)
+		assert.ErrorIs(t, err, ErrInvalidThreshold)
+		_, err = GenerateMultisignAddress(1, [][]byte{publicKeys[0], publicKeys[0]}, address.BitcoinNetworkBitcoin)
+		assert.ErrorIs(t, err, ErrDuplicatePublicKey)
+		_, err = GenerateMultisignAddress(1, [][]byte{publicKeys[0][1:]}, address.BitcoinNetworkBitcoin)
+		assert.ErrorIs(t, err, ErrInvalidPublicKey)
+	})
+}
+
+func TestPartiallySignedTransaction(t *testing.T) {
+	keypairs, publicKeys := newTestKeypairs(t, 3)
+	data := newTestTransactionData(t, 2, publicKeys)
+
+	t.Run("2 of 3 with offline hand-off", func(t *testing.T) {
+		pst, err := NewPartiallySignedTransaction(data, "", 2, publicKeys)
+		assert.NoError(t, err)
+		assert.NoError(t, pst.Sign(keypairs[0]))
+		assert.False(t, pst.IsComplete())
+		_, err = pst.Finalize()
+		assert.ErrorIs(t, err, ErrNotEnoughSignatures)
+
+		encoded, err := pst.ToHex()
+		assert.NoError(t, err)
+		received, err := PartiallySignedTransactionFromHex(encoded)
+		assert.NoError(t, err)
+		reencoded, err := received.ToHex()
+		assert.NoError(t, err)
+		assert.Equal(t, encoded, reencoded)
+		assert.Equal(t, 1, received.SignatureCount())
+
+		assert.NoError(t, received.Sign(keypairs[2]))
+		assert.True(t, received.IsComplete())
+
+		tx, err := received.Finalize()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(crypto.AuthValidatorTypeBitcoinMultisign), tx.Authenticator.AuthValidatorId)
+
+		payload := crypto.MultisignAuthPayload{}
+		assert.NoError(t, bcs.Deserialize(&payload, tx.Authenticator.Payload))
+		assert.Len(t, payload.Signatures, 2)
+		message, err := received.SignMessage()
+		assert.NoError(t, err)
+		for i, pk := range payload.PublicKeys {
+			key, err := secp256k1.NewSecp256k1PublicKey(pk)
+			assert.NoError(t, err)
+			valid, _ := key.Verify(message.Hash(), payload.Signatures[i])
+			assert.True(t, valid)
+		}
+	})
+
+	t.Run("Reject outsiders and bad signatures", func(t *testing.T) {
+		pst, err := NewPartiallySignedTransaction(data, "", 2, publicKeys)
+		assert.NoError(t, err)
+		outsider, _ := secp256k1.GenerateSecp256k1Keypair()
+		assert.ErrorIs(t, pst.Sign(outsider), ErrNotAMember)
+
+		signature, err := keypairs[1].Sign([]byte("something else"))
+		assert.NoError(t, err)
+		assert.ErrorIs(t, pst.AddSignature(keypairs[1].GetPublicKey().ToBytes(), signature), ErrInvalidSignature)
+		assert.Equal(t, 0, pst.SignatureCount())
+	})
+}
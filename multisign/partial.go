@@ -0,0 +1,215 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package multisign
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+var (
+	ErrNotAMember          = errors.New("public key is not a member of the multisign account")
+	ErrInvalidSignature    = errors.New("invalid multisign signature")
+	ErrNotEnoughSignatures = errors.New("not enough signatures to reach the threshold")
+)
+
+// PartiallySignedTransaction carries a multisign transaction between its signers.
+//
+// PublicKeys holds every member of the account in canonical order and Signatures[i]
+// is the signature of PublicKeys[i], empty while that member has not signed yet.
+type PartiallySignedTransaction struct {
+	Data       types.TransactionData
+	Info       string
+	Threshold  uint64
+	PublicKeys [][]byte
+	Signatures [][]byte
+}
+
+// NewPartiallySignedTransaction creates an unsigned transaction for the multisign account
+// described by threshold and publicKeys, data.Sender should be its Rooch address
+func NewPartiallySignedTransaction(data types.TransactionData, info string, threshold uint64, publicKeys [][]byte) (*PartiallySignedTransaction, error) {
+	sorted, err := SortPublicKeys(publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkThreshold(threshold, len(sorted)); err != nil {
+		return nil, err
+	}
+	return &PartiallySignedTransaction{
+		Data:       data,
+		Info:       info,
+		Threshold:  threshold,
+		PublicKeys: sorted,
+		Signatures: make([][]byte, len(sorted)),
+	}, nil
+}
+
+// SignMessage returns the Bitcoin message every member signs
+func (pst *PartiallySignedTransaction) SignMessage() (*crypto.BitcoinSignMessage, error) {
+	hash, err := pst.Data.Hash()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.NewBitcoinSignMessage(hash, pst.Info), nil
+}
+
+func (pst *PartiallySignedTransaction) indexOf(publicKey []byte) int {
+	for i, pk := range pst.PublicKeys {
+		if bytes.Equal(pk, publicKey) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Sign adds the signature of signer, which must be a Secp256k1 member of the account
+func (pst *PartiallySignedTransaction) Sign(signer crypto.Signer) error {
+	message, err := pst.SignMessage()
+	if err != nil {
+		return err
+	}
+	signature, err := signer.Sign(message.Hash())
+	if err != nil {
+		return err
+	}
+	return pst.AddSignature(signer.GetPublicKey().ToBytes(), signature)
+}
+
+// AddSignature adds a signature produced elsewhere after checking it against the member public key
+func (pst *PartiallySignedTransaction) AddSignature(publicKey []byte, signature []byte) error {
+	index := pst.indexOf(publicKey)
+	if index < 0 {
+		return ErrNotAMember
+	}
+	message, err := pst.SignMessage()
+	if err != nil {
+		return err
+	}
+	pk, err := secp256k1.NewSecp256k1PublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	valid, err := pk.Verify(message.Hash(), signature)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+	pst.Signatures[index] = signature
+	return nil
+}
+
+// SignatureCount returns the number of members that have signed
+func (pst *PartiallySignedTransaction) SignatureCount() int {
+	count := 0
+	for _, s := range pst.Signatures {
+		if len(s) != 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// IsComplete reports whether the threshold is reached
+func (pst *PartiallySignedTransaction) IsComplete() bool {
+	return uint64(pst.SignatureCount()) >= pst.Threshold
+}
+
+// Finalize combines the collected signatures into the multisign authenticator and
+// returns the transaction ready to be submitted
+func (pst *PartiallySignedTransaction) Finalize() (*types.Transaction, error) {
+	if !pst.IsComplete() {
+		return nil, fmt.Errorf("%w: have %d, need %d", ErrNotEnoughSignatures, pst.SignatureCount(), pst.Threshold)
+	}
+	message, err := pst.SignMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	var signatures, publicKeys [][]byte
+	for i, s := range pst.Signatures {
+		if len(s) == 0 {
+			continue
+		}
+		signatures = append(signatures, s)
+		publicKeys = append(publicKeys, pst.PublicKeys[i])
+		if uint64(len(signatures)) == pst.Threshold {
+			break
+		}
+	}
+
+	auth, err := crypto.BitcoinMultisignAuthValidator(message, signatures, publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Transaction{
+		Data:          pst.Data,
+		Authenticator: *auth,
+		Info:          pst.Info,
+	}, nil
+}
+
+func (pst *PartiallySignedTransaction) MarshalBCS(ser *bcs.Serializer) {
+	pst.Data.MarshalBCS(ser)
+	ser.WriteString(pst.Info)
+	ser.U64(pst.Threshold)
+	ser.Uleb128(uint32(len(pst.PublicKeys)))
+	for _, pk := range pst.PublicKeys {
+		ser.WriteBytes(pk)
+	}
+	ser.Uleb128(uint32(len(pst.Signatures)))
+	for _, s := range pst.Signatures {
+		ser.WriteBytes(s)
+	}
+}
+
+func (pst *PartiallySignedTransaction) UnmarshalBCS(des *bcs.Deserializer) {
+	pst.Data.UnmarshalBCS(des)
+	pst.Info = des.ReadString()
+	pst.Threshold = des.U64()
+	plen := des.Uleb128()
+	pst.PublicKeys = make([][]byte, plen)
+	for i := range plen {
+		pst.PublicKeys[i] = des.ReadBytes()
+	}
+	slen := des.Uleb128()
+	if slen != plen {
+		des.SetError(fmt.Errorf("signatures length %d does not match public keys length %d", slen, plen))
+		return
+	}
+	pst.Signatures = make([][]byte, slen)
+	for i := range slen {
+		pst.Signatures[i] = des.ReadBytes()
+	}
+}
+
+// ToHex serializes the transaction for the hand-off to the next signer
+func (pst *PartiallySignedTransaction) ToHex() (string, error) {
+	data, err := bcs.Serialize(pst)
+	if err != nil {
+		return "", err
+	}
+	return utils.ToHEX(data)
+}
+
+// PartiallySignedTransactionFromHex decodes a transaction produced by [PartiallySignedTransaction.ToHex]
+func PartiallySignedTransactionFromHex(input string) (*PartiallySignedTransaction, error) {
+	data, err := utils.HexToBytes(input)
+	if err != nil {
+		return nil, err
+	}
+	pst := &PartiallySignedTransaction{}
+	if err := bcs.Deserialize(pst, data); err != nil {
+		return nil, err
+	}
+	return pst, nil
+}
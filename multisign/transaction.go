@@ -0,0 +1,50 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package multisign
+
+import (
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+const (
+	MultisignAccountModule       = "multisign_account"
+	InitializeMultisignAccountFn = "initialize_multisig_account_entry"
+)
+
+// NewCreateMultisignAccountCall builds the call to
+// 0x3::multisign_account::initialize_multisig_account_entry(threshold, public_keys),
+// any account can send it, the multisign account is created at [GenerateMultisignRoochAddress]
+func NewCreateMultisignAccountCall(threshold uint64, publicKeys [][]byte) (*types.FunctionCall, error) {
+	sorted, err := SortPublicKeys(publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkThreshold(threshold, len(sorted)); err != nil {
+		return nil, err
+	}
+
+	thresholdArg, err := bcs.SerializeU64(threshold)
+	if err != nil {
+		return nil, err
+	}
+	publicKeysArg, err := bcs.SerializeSingle(func(ser *bcs.Serializer) {
+		ser.Uleb128(uint32(len(sorted)))
+		for _, pk := range sorted {
+			ser.WriteBytes(pk)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.FunctionCall{
+		FunctionId: types.FunctionId{
+			ModuleId:     types.ModuleId{Address: types.AddressThree, Name: MultisignAccountModule},
+			FunctionName: InitializeMultisignAccountFn,
+		},
+		TypeArgs: []types.TypeTag{},
+		Args:     [][]byte{thresholdArg, publicKeysArg},
+	}, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package nostr
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+var (
+	ErrInvalidEventID   = errors.New("event id does not match the event content")
+	ErrInvalidSignature = errors.New("invalid event signature")
+)
+
+// Event is a NIP-01 event, keys, ID and signature are lowercase hex as in the wire format
+type Event struct {
+	ID        string     `json:"id"`
+	PubKey    string     `json:"pubkey"`
+	CreatedAt int64      `json:"created_at"`
+	Kind      int        `json:"kind"`
+	Tags      [][]string `json:"tags"`
+	Content   string     `json:"content"`
+	Sig       string     `json:"sig"`
+}
+
+// Serialize returns the NIP-01 serialization of the event that is hashed into its ID,
+// [0,pubkey,created_at,kind,tags,content] without whitespace
+func (e *Event) Serialize() []byte {
+	var out strings.Builder
+	out.WriteString(`[0,`)
+	writeJSONString(&out, e.PubKey)
+	out.WriteRune(',')
+	out.WriteString(strconv.FormatInt(e.CreatedAt, 10))
+	out.WriteRune(',')
+	out.WriteString(strconv.Itoa(e.Kind))
+	out.WriteString(`,[`)
+	for i, tag := range e.Tags {
+		if i != 0 {
+			out.WriteRune(',')
+		}
+		out.WriteRune('[')
+		for j, value := range tag {
+			if j != 0 {
+				out.WriteRune(',')
+			}
+			writeJSONString(&out, value)
+		}
+		out.WriteRune(']')
+	}
+	out.WriteString(`],`)
+	writeJSONString(&out, e.Content)
+	out.WriteRune(']')
+	return []byte(out.String())
+}
+
+// GetID computes the event ID, the sha256 of the serialized event
+func (e *Event) GetID() string {
+	return hex.EncodeToString(utils.Sha256(e.Serialize()))
+}
+
+// Sign sets the public key, ID and Schnorr signature of the event from the keypair
+func (e *Event) Sign(kp *secp256k1.Secp256k1Keypair) error {
+	privateKey, _ := btcec.PrivKeyFromBytes(kp.GetSecretKey())
+	e.PubKey = hex.EncodeToString(PublicKey(kp))
+	e.ID = e.GetID()
+
+	id, _ := hex.DecodeString(e.ID)
+	signature, err := schnorr.Sign(privateKey, id)
+	if err != nil {
+		return err
+	}
+	e.Sig = hex.EncodeToString(signature.Serialize())
+	return nil
+}
+
+// Verify checks the event ID against the content and the signature against the public key
+func (e *Event) Verify() error {
+	if e.ID != e.GetID() {
+		return ErrInvalidEventID
+	}
+	publicKeyBytes, err := hex.DecodeString(e.PubKey)
+	if err != nil {
+		return err
+	}
+	publicKey, err := schnorr.ParsePubKey(publicKeyBytes)
+	if err != nil {
+		return err
+	}
+	signatureBytes, err := hex.DecodeString(e.Sig)
+	if err != nil {
+		return err
+	}
+	signature, err := schnorr.ParseSignature(signatureBytes)
+	if err != nil {
+		return err
+	}
+	id, _ := hex.DecodeString(e.ID)
+	if !signature.Verify(id, publicKey) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// RoochAddress resolves the event author to its Rooch account
+func (e *Event) RoochAddress() (*address.RoochAddress, error) {
+	publicKey, err := hex.DecodeString(e.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return RoochAddress(publicKey)
+}
+
+// writeJSONString writes a JSON string with only the escapes NIP-01 allows, every other
+// character, non ASCII included, is written as is
+func writeJSONString(out *strings.Builder, s string) {
+	out.WriteRune('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			out.WriteString(`\"`)
+		case '\\':
+			out.WriteString(`\\`)
+		case '\n':
+			out.WriteString(`\n`)
+		case '\r':
+			out.WriteString(`\r`)
+		case '\t':
+			out.WriteString(`\t`)
+		case '\b':
+			out.WriteString(`\b`)
+		case '\f':
+			out.WriteString(`\f`)
+		default:
+			out.WriteRune(r)
+		}
+	}
+	out.WriteRune('"')
+}
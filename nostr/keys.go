@@ -0,0 +1,122 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+// Package nostr implements Nostr identities on top of Secp256k1 keys: NIP-19
+// keys and entities, NIP-01 events with their Schnorr signatures, and the
+// mapping of a Nostr public key to its Rooch account.
+package nostr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+)
+
+const (
+	PrefixNpub     = address.PREFIX_BECH32_PUBLIC_KEY
+	PrefixNsec     = "nsec"
+	PrefixNprofile = "nprofile"
+	PrefixNevent   = "nevent"
+
+	KeyLength = 32
+)
+
+var (
+	ErrInvalidPrefix    = errors.New("invalid nostr bech32 prefix")
+	ErrInvalidKeyLength = errors.New("invalid nostr key length")
+)
+
+// EncodeNsec encodes a 32 bytes secret key as a NIP-19 nsec string
+func EncodeNsec(secretKey []byte) (string, error) {
+	if len(secretKey) != KeyLength {
+		return "", ErrInvalidKeyLength
+	}
+	return encodeBech32(PrefixNsec, secretKey)
+}
+
+// DecodeNsec decodes a NIP-19 nsec string to the 32 bytes secret key
+func DecodeNsec(nsec string) ([]byte, error) {
+	return decodeKey(PrefixNsec, nsec)
+}
+
+// EncodeNpub encodes a 32 bytes x-only public key as a NIP-19 npub string
+func EncodeNpub(publicKey []byte) (string, error) {
+	if len(publicKey) != KeyLength {
+		return "", ErrInvalidKeyLength
+	}
+	return encodeBech32(PrefixNpub, publicKey)
+}
+
+// DecodeNpub decodes a NIP-19 npub string to the 32 bytes x-only public key
+func DecodeNpub(npub string) ([]byte, error) {
+	return decodeKey(PrefixNpub, npub)
+}
+
+// KeypairFromNsec imports the Secp256k1 keypair of a NIP-19 nsec string
+func KeypairFromNsec(nsec string) (*secp256k1.Secp256k1Keypair, error) {
+	secretKey, err := DecodeNsec(nsec)
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1.FromSecp256k1SecretKey(secretKey, false)
+}
+
+// ExportNsec exports the secret key of the keypair as a NIP-19 nsec string
+func ExportNsec(kp *secp256k1.Secp256k1Keypair) (string, error) {
+	return EncodeNsec(kp.GetSecretKey())
+}
+
+// PublicKey returns the x-only public key of the keypair, the Nostr identity of the key
+func PublicKey(kp *secp256k1.Secp256k1Keypair) []byte {
+	return kp.GetSchnorrPublicKey().ToBytes()
+}
+
+// RoochAddress resolves the x-only public key of a Nostr identity to its Rooch account
+func RoochAddress(publicKey []byte) (*address.RoochAddress, error) {
+	if len(publicKey) != KeyLength {
+		return nil, ErrInvalidKeyLength
+	}
+	nostrAddress, err := address.NewNostrAddress(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return nostrAddress.GenRoochAddress()
+}
+
+func decodeKey(prefix string, input string) ([]byte, error) {
+	hrp, data, err := decodeBech32(input)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != prefix {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrInvalidPrefix, prefix, hrp)
+	}
+	if len(data) != KeyLength {
+		return nil, ErrInvalidKeyLength
+	}
+	return data, nil
+}
+
+func encodeBech32(prefix string, data []byte) (string, error) {
+	converted, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(prefix, converted)
+}
+
+// decodeBech32 decodes a NIP-19 string, entities with TLV data may exceed the 90 characters bech32 limit
+func decodeBech32(input string) (string, []byte, error) {
+	hrp, data, err := bech32.DecodeNoLimit(input)
+	if err != nil {
+		return "", nil, err
+	}
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, converted, nil
+}
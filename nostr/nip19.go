@@ -0,0 +1,158 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package nostr
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// NIP-19 TLV types
+const (
+	TLVSpecial uint8 = 0
+	TLVRelay   uint8 = 1
+	TLVAuthor  uint8 = 2
+	TLVKind    uint8 = 3
+)
+
+var ErrInvalidTLV = errors.New("invalid nostr TLV entity")
+
+// ProfilePointer is the content of a NIP-19 nprofile
+type ProfilePointer struct {
+	PublicKey []byte
+	Relays    []string
+}
+
+// EventPointer is the content of a NIP-19 nevent, Author and Kind are optional
+type EventPointer struct {
+	ID     []byte
+	Relays []string
+	Author []byte
+	Kind   *uint32
+}
+
+// EncodeProfile encodes a profile pointer as a NIP-19 nprofile string
+func EncodeProfile(profile *ProfilePointer) (string, error) {
+	if len(profile.PublicKey) != KeyLength {
+		return "", ErrInvalidKeyLength
+	}
+	tlv := appendTLV(nil, TLVSpecial, profile.PublicKey)
+	for _, relay := range profile.Relays {
+		tlv = appendTLV(tlv, TLVRelay, []byte(relay))
+	}
+	return encodeBech32(PrefixNprofile, tlv)
+}
+
+// DecodeProfile decodes a NIP-19 nprofile string
+func DecodeProfile(nprofile string) (*ProfilePointer, error) {
+	entries, err := decodeEntity(PrefixNprofile, nprofile)
+	if err != nil {
+		return nil, err
+	}
+	profile := &ProfilePointer{}
+	for _, entry := range entries {
+		switch entry.kind {
+		case TLVSpecial:
+			if len(entry.value) != KeyLength {
+				return nil, ErrInvalidKeyLength
+			}
+			profile.PublicKey = entry.value
+		case TLVRelay:
+			profile.Relays = append(profile.Relays, string(entry.value))
+		}
+	}
+	if profile.PublicKey == nil {
+		return nil, fmt.Errorf("%w: missing public key", ErrInvalidTLV)
+	}
+	return profile, nil
+}
+
+// EncodeEvent encodes an event pointer as a NIP-19 nevent string
+func EncodeEvent(event *EventPointer) (string, error) {
+	if len(event.ID) != KeyLength {
+		return "", fmt.Errorf("%w: invalid event id length %d", ErrInvalidTLV, len(event.ID))
+	}
+	tlv := appendTLV(nil, TLVSpecial, event.ID)
+	for _, relay := range event.Relays {
+		tlv = appendTLV(tlv, TLVRelay, []byte(relay))
+	}
+	if event.Author != nil {
+		if len(event.Author) != KeyLength {
+			return "", ErrInvalidKeyLength
+		}
+		tlv = appendTLV(tlv, TLVAuthor, event.Author)
+	}
+	if event.Kind != nil {
+		tlv = appendTLV(tlv, TLVKind, binary.BigEndian.AppendUint32(nil, *event.Kind))
+	}
+	return encodeBech32(PrefixNevent, tlv)
+}
+
+// DecodeEvent decodes a NIP-19 nevent string
+func DecodeEvent(nevent string) (*EventPointer, error) {
+	entries, err := decodeEntity(PrefixNevent, nevent)
+	if err != nil {
+		return nil, err
+	}
+	event := &EventPointer{}
+	for _, entry := range entries {
+		switch entry.kind {
+		case TLVSpecial:
+			if len(entry.value) != KeyLength {
+				return nil, fmt.Errorf("%w: invalid event id length %d", ErrInvalidTLV, len(entry.value))
+			}
+			event.ID = entry.value
+		case TLVRelay:
+			event.Relays = append(event.Relays, string(entry.value))
+		case TLVAuthor:
+			if len(entry.value) != KeyLength {
+				return nil, ErrInvalidKeyLength
+			}
+			event.Author = entry.value
+		case TLVKind:
+			if len(entry.value) != 4 {
+				return nil, fmt.Errorf("%w: invalid kind length %d", ErrInvalidTLV, len(entry.value))
+			}
+			kind := binary.BigEndian.Uint32(entry.value)
+			event.Kind = &kind
+		}
+	}
+	if event.ID == nil {
+		return nil, fmt.Errorf("%w: missing event id", ErrInvalidTLV)
+	}
+	return event, nil
+}
+
+type tlvEntry struct {
+	kind  uint8
+	value []byte
+}
+
+func decodeEntity(prefix string, input string) ([]tlvEntry, error) {
+	hrp, data, err := decodeBech32(input)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != prefix {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrInvalidPrefix, prefix, hrp)
+	}
+
+	// Unknown types are kept so that callers skip them, as NIP-19 requires
+	var entries []tlvEntry
+	for len(data) > 0 {
+		if len(data) < 2 || len(data) < 2+int(data[1]) {
+			return nil, fmt.Errorf("%w: truncated entry", ErrInvalidTLV)
+		}
+		length := int(data[1])
+		entries = append(entries, tlvEntry{kind: data[0], value: data[2 : 2+length]})
+		data = data[2+length:]
+	}
+	return entries, nil
+}
+
+func appendTLV(data []byte, kind uint8, value []byte) []byte {
+	data = append(data, kind, byte(len(value)))
+	return append(data, value...)
+}
@@ -0,0 +1,124 @@
+package nostr
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeys(t *testing.T) {
+	// NIP-19 test vectors
+	nsec := "nsec1vl029mgpspedva04g90vltkh6fvh240zqtv9k0t9af8935ke9laqsnlfe5"
+	secretKey := "67dea2ed018072d675f5415ecfaed7d2597555e202d85b3d65ea4e58d2d92ffa"
+	npub := "npub10elfcs4fr0l0r8af98jlmgdh9c8tcxjvz9qkw038js35mp4dma8qzvjptg"
+	publicKey := "7e7e9c42a91bfef19fa929e5fda1b72e0ebc1a4c1141673e2794234d86addf4e"
+
+	t.Run("nsec", func(t *testing.T) {
+		decoded, err := DecodeNsec(nsec)
+		assert.NoError(t, err)
+		assert.Equal(t, secretKey, hex.EncodeToString(decoded))
+
+		kp, err := KeypairFromNsec(nsec)
+		assert.NoError(t, err)
+		exported, err := ExportNsec(kp)
+		assert.NoError(t, err)
+		assert.Equal(t, nsec, exported)
+
+		_, err = DecodeNsec(npub)
+		assert.ErrorIs(t, err, ErrInvalidPrefix)
+	})
+
+	t.Run("npub", func(t *testing.T) {
+		decoded, err := DecodeNpub(npub)
+		assert.NoError(t, err)
+		assert.Equal(t, publicKey, hex.EncodeToString(decoded))
+
+		encoded, err := EncodeNpub(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, npub, encoded)
+	})
+
+	t.Run("Rooch address", func(t *testing.T) {
+		kp, _ := KeypairFromNsec(nsec)
+		roochAddress, err := RoochAddress(PublicKey(kp))
+		assert.NoError(t, err)
+
+		// A Nostr identity maps to the Rooch account of the same key
+		expect, _ := kp.GetRoochAddress()
+		assert.Equal(t, expect.String(), roochAddress.String())
+
+		nostrAddress, _ := address.NewNostrAddress(PublicKey(kp))
+		fromNpub, _ := nostrAddress.GenRoochAddress()
+		assert.Equal(t, expect.String(), fromNpub.String())
+	})
+}
+
+func TestNIP19Entities(t *testing.T) {
+	t.Run("nprofile", func(t *testing.T) {
+		nprofile := "nprofile1qqsrhuxx8l9ex335q7he0f09aej04zpazpl0ne2cgukyawd24mayt8gpp4mhxue69uhhytnc9e3k7mgpz4mhxue69uhkg6nzv9ejuumpv34kytnrdaksjlyr9p"
+		profile, err := DecodeProfile(nprofile)
+		assert.NoError(t, err)
+		assert.Equal(t, "3bf0c63fcb93463407af97a5e5ee64fa883d107ef9e558472c4eb9aaaefa459d", hex.EncodeToString(profile.PublicKey))
+		assert.Equal(t, []string{"wss://r.x.com", "wss://djbas.sadkb.com"}, profile.Relays)
+
+		encoded, err := EncodeProfile(profile)
+		assert.NoError(t, err)
+		assert.Equal(t, nprofile, encoded)
+	})
+
+	t.Run("nevent", func(t *testing.T) {
+		kind := uint32(1)
+		event := &EventPointer{
+			ID:     utils.Sha256([]byte("event")),
+			Relays: []string{"wss://relay.example.com"},
+			Author: make([]byte, KeyLength),
+			Kind:   &kind,
+		}
+		nevent, err := EncodeEvent(event)
+		assert.NoError(t, err)
+		decoded, err := DecodeEvent(nevent)
+		assert.NoError(t, err)
+		assert.Equal(t, event, decoded)
+
+		_, err = DecodeEvent(nevent[:len(nevent)-1])
+		assert.Error(t, err)
+	})
+}
+
+func TestEvent(t *testing.T) {
+	kp, _ := KeypairFromNsec("nsec1vl029mgpspedva04g90vltkh6fvh240zqtv9k0t9af8935ke9laqsnlfe5")
+	event := &Event{
+		CreatedAt: 1700000000,
+		Kind:      1,
+		Tags:      [][]string{{"rooch", "0x3"}},
+		Content:   "hello \"rooch\"\n<&>",
+	}
+
+	t.Run("Serialize", func(t *testing.T) {
+		event.PubKey = "7e7e9c42a91bfef19fa929e5fda1b72e0ebc1a4c1141673e2794234d86addf4e"
+		expect := `[0,"7e7e9c42a91bfef19fa929e5fda1b72e0ebc1a4c1141673e2794234d86addf4e",1700000000,1,[["rooch","0x3"]],"hello \"rooch\"\n<&>"]`
+		assert.Equal(t, expect, string(event.Serialize()))
+		assert.Equal(t, hex.EncodeToString(utils.Sha256([]byte(expect))), event.GetID())
+	})
+
+	t.Run("Sign and verify", func(t *testing.T) {
+		assert.NoError(t, event.Sign(kp))
+		assert.Equal(t, hex.EncodeToString(PublicKey(kp)), event.PubKey)
+		assert.NoError(t, event.Verify())
+
+		data, err := json.Marshal(event)
+		assert.NoError(t, err)
+		var decoded Event
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.NoError(t, decoded.Verify())
+
+		decoded.Content = "tampered"
+		assert.ErrorIs(t, decoded.Verify(), ErrInvalidEventID)
+		decoded.ID = decoded.GetID()
+		assert.ErrorIs(t, decoded.Verify(), ErrInvalidSignature)
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package ordinals
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+// protocolID is the push following OP_FALSE OP_IF which marks an ord envelope
+var protocolID = []byte("ord")
+
+// The tags of the fields of an envelope, the body follows an empty push in place of a tag
+const (
+	tagContentType     = 1
+	tagPointer         = 2
+	tagParent          = 3
+	tagMetadata        = 5
+	tagMetaprotocol    = 7
+	tagContentEncoding = 9
+)
+
+// annexTag is the first byte of the annex, the optional last witness element
+const annexTag = 0x50
+
+// Inscription is the content of an ord envelope, the string fields are empty when the tag is absent
+type Inscription struct {
+	ContentType     string
+	ContentEncoding string
+	Metaprotocol    string
+	Metadata        []byte
+	Parents         []types.InscriptionID
+	Pointer         *uint64
+	Body            []byte
+}
+
+// Envelope is an inscription revealed in a transaction, Input is the index of the input whose witness holds
+// it and ID the inscription ID, indexed by the order of the envelopes in the transaction
+type Envelope struct {
+	Inscription
+	Input uint32
+	ID    types.InscriptionID
+}
+
+// ParseTransaction parses the envelopes in the witnesses of the inputs of the transaction
+func ParseTransaction(tx *wire.MsgTx) []Envelope {
+	txid := tx.TxHash()
+	var envelopes []Envelope
+	for i, txIn := range tx.TxIn {
+		for _, inscription := range ParseWitness(txIn.Witness) {
+			envelopes = append(envelopes, Envelope{
+				Inscription: inscription,
+				Input:       uint32(i),
+				ID:          types.InscriptionID{Txid: types.RoochAddress(txid), Index: uint32(len(envelopes))},
+			})
+		}
+	}
+	return envelopes
+}
+
+// ParseWitness parses the envelopes in the tapscript of a script path spend witness, none for other witnesses
+func ParseWitness(witness wire.TxWitness) []Inscription {
+	if len(witness) > 1 && len(witness[len(witness)-1]) > 0 && witness[len(witness)-1][0] == annexTag {
+		witness = witness[:len(witness)-1]
+	}
+	if len(witness) < 2 {
+		return nil
+	}
+	return ParseScript(witness[len(witness)-2])
+}
+
+// ParseScript parses the envelopes in the tapscript. An envelope is OP_FALSE OP_IF "ord" followed by pushes
+// up to OP_ENDIF, the envelopes holding other opcodes are not inscriptions and left out
+func ParseScript(script []byte) []Inscription {
+	var inscriptions []Inscription
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	// The last three instructions, to find OP_FALSE OP_IF "ord"
+	var window [3]instruction
+	for tokenizer.Next() {
+		window[0], window[1] = window[1], window[2]
+		window[2] = instruction{opcode: tokenizer.Opcode(), data: tokenizer.Data()}
+		if !window[0].isEmptyPush() || window[1].opcode != txscript.OP_IF || !window[2].isPush() || !bytes.Equal(window[2].data, protocolID) {
+			continue
+		}
+		window = [3]instruction{}
+		if pushes, ok := envelopePushes(&tokenizer); ok {
+			inscriptions = append(inscriptions, parsePayload(pushes))
+		}
+	}
+	return inscriptions
+}
+
+type instruction struct {
+	opcode byte
+	data   []byte
+}
+
+func (i instruction) isPush() bool {
+	return i.opcode <= txscript.OP_PUSHDATA4
+}
+
+func (i instruction) isEmptyPush() bool {
+	return i.isPush() && len(i.data) == 0
+}
+
+// envelopePushes reads the pushes of the envelope up to OP_ENDIF, it is not valid if another opcode comes first
+func envelopePushes(tokenizer *txscript.ScriptTokenizer) ([][]byte, bool) {
+	var pushes [][]byte
+	for tokenizer.Next() {
+		opcode := tokenizer.Opcode()
+		switch {
+		case opcode == txscript.OP_ENDIF:
+			return pushes, true
+		case opcode <= txscript.OP_PUSHDATA4:
+			pushes = append(pushes, tokenizer.Data())
+		case opcode == txscript.OP_1NEGATE:
+			pushes = append(pushes, []byte{0x81})
+		case opcode >= txscript.OP_1 && opcode <= txscript.OP_16:
+			pushes = append(pushes, []byte{opcode - txscript.OP_1 + 1})
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// parsePayload reads the tag and value pairs of the pushes, the pushes after the body tag are the body
+func parsePayload(pushes [][]byte) Inscription {
+	inscription := Inscription{}
+	fields := make(map[byte][][]byte)
+	for i := 0; i < len(pushes); i += 2 {
+		if len(pushes[i]) == 0 {
+			inscription.Body = bytes.Join(pushes[i+1:], nil)
+			break
+		}
+		if len(pushes[i]) != 1 || i+1 >= len(pushes) {
+			// A tag is a single byte, the unknown and incomplete fields are ignored
+			continue
+		}
+		fields[pushes[i][0]] = append(fields[pushes[i][0]], pushes[i+1])
+	}
+
+	first := func(tag byte) []byte {
+		if values := fields[tag]; len(values) > 0 {
+			return values[0]
+		}
+		return nil
+	}
+	inscription.ContentType = string(first(tagContentType))
+	inscription.ContentEncoding = string(first(tagContentEncoding))
+	inscription.Metaprotocol = string(first(tagMetaprotocol))
+	// The metadata is split in pushes of at most 520 bytes
+	if values := fields[tagMetadata]; len(values) > 0 {
+		inscription.Metadata = bytes.Join(values, nil)
+	}
+	for _, value := range fields[tagParent] {
+		if parent, ok := decodeInscriptionID(value); ok {
+			inscription.Parents = append(inscription.Parents, parent)
+		}
+	}
+	if value := first(tagPointer); value != nil {
+		if pointer, ok := decodeLittleEndian(value); ok {
+			inscription.Pointer = &pointer
+		}
+	}
+	return inscription
+}
+
+// encodeInscriptionID encodes the inscription ID of a parent, the txid in the internal byte order
+// followed by the little endian index without its trailing zeros
+func encodeInscriptionID(id types.InscriptionID) []byte {
+	return append(id.Txid[:], encodeLittleEndian(uint64(id.Index))...)
+}
+
+func decodeInscriptionID(value []byte) (types.InscriptionID, bool) {
+	if len(value) < chainhash.HashSize || len(value) > chainhash.HashSize+4 {
+		return types.InscriptionID{}, false
+	}
+	id := types.InscriptionID{}
+	copy(id.Txid[:], value[:chainhash.HashSize])
+	index, _ := decodeLittleEndian(value[chainhash.HashSize:])
+	id.Index = uint32(index)
+	return id, true
+}
+
+// encodeLittleEndian encodes the integer in little endian without its trailing zeros
+func encodeLittleEndian(value uint64) []byte {
+	encoded := binary.LittleEndian.AppendUint64(nil, value)
+	return bytes.TrimRight(encoded, "\x00")
+}
+
+// decodeLittleEndian decodes a little endian integer, the trailing zeros past 8 bytes are allowed
+func decodeLittleEndian(value []byte) (uint64, bool) {
+	if len(value) > 8 {
+		if len(bytes.TrimRight(value[8:], "\x00")) > 0 {
+			return 0, false
+		}
+		value = value[:8]
+	}
+	var buf [8]byte
+	copy(buf[:], value)
+	return binary.LittleEndian.Uint64(buf[:]), true
+}
@@ -0,0 +1,42 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package ordinals
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+var ErrNotInscriptionObject = errors.New("object is not an inscription")
+
+// InscriptionObjectID returns the ID of the Rooch object of the inscription, e.g. of 6fb976ab...a3b4i0
+func InscriptionObjectID(inscriptionID string) (types.ObjectID, error) {
+	id, err := types.ParseInscriptionID(inscriptionID)
+	if err != nil {
+		return types.ObjectID{}, err
+	}
+	return id.ObjectID()
+}
+
+// InscriptionIDFromObject returns the inscription ID of the Inscription object. The ID of the object is
+// derived from the inscription ID and cannot be reversed, it is checked against the ID of the decoded value
+func InscriptionIDFromObject(state *types.ObjectStateView) (types.InscriptionID, error) {
+	if objectType := state.Metadata.ObjectType.String(); objectType != types.InscriptionStructTag.String() {
+		return types.InscriptionID{}, fmt.Errorf("%w: %s", ErrNotInscriptionObject, objectType)
+	}
+	inscription := &types.Inscription{}
+	if err := state.DecodeValue(inscription); err != nil {
+		return types.InscriptionID{}, err
+	}
+	objectID, err := inscription.ID.ObjectID()
+	if err != nil {
+		return types.InscriptionID{}, err
+	}
+	if !objectID.Equals(&state.Metadata.ID) {
+		return types.InscriptionID{}, fmt.Errorf("%w: %s is not the object of %s", ErrNotInscriptionObject, state.Metadata.ID.String(), inscription.ID.String())
+	}
+	return inscription.ID, nil
+}
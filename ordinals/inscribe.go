@@ -0,0 +1,227 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package ordinals
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bitcoin"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/rooch-network/rooch-go-sdk/types"
+)
+
+// DefaultPostage is the value in sats of the output holding a new inscription, as in ord
+const DefaultPostage = 10000
+
+// maxPushSize is the largest push of a tapscript, the body and the metadata are split in pushes of this size
+const maxPushSize = txscript.MaxScriptElementSize
+
+// InscribeParams describes a new inscription. Destination receives the inscription, the key path P2TR address
+// of the keypair when empty. The commit transaction is funded with the available UTXOs at FeeRate sat/vB,
+// with the change to ChangeAddress or the script of its first input
+type InscribeParams struct {
+	Inscription   Inscription
+	Destination   string
+	Postage       int64
+	FeeRate       float64
+	ChangeAddress string
+}
+
+// CommitReveal is a signed commit and reveal transaction pair. The commit transaction pays to the taproot
+// output committing to the reveal script, the reveal transaction spends it through the script path and
+// reveals the inscription to its first output. The commit transaction must be broadcast first
+type CommitReveal struct {
+	Commit        *wire.MsgTx
+	Reveal        *wire.MsgTx
+	CommitFee     int64
+	RevealFee     int64
+	RevealScript  []byte
+	InscriptionID types.InscriptionID
+}
+
+// Inscriber creates commit and reveal transactions of inscriptions, the reveal script is locked to the
+// taproot key of the keypair which also signs the UTXOs funding the commit transaction
+type Inscriber struct {
+	network address.BitcoinNetworkType
+	keypair *secp256k1.Secp256k1Keypair
+}
+
+// NewInscriber creates an inscriber of the keypair on the network
+func NewInscriber(network address.BitcoinNetworkType, keypair *secp256k1.Secp256k1Keypair) *Inscriber {
+	return &Inscriber{network: network, keypair: keypair}
+}
+
+// RevealScript returns the tapscript revealing the inscription, a checksig of the x-only public key followed
+// by the envelope of the inscription
+func RevealScript(publicKey *btcec.PublicKey, inscription *Inscription) []byte {
+	script := pushData(nil, schnorr.SerializePubKey(publicKey))
+	script = append(script, txscript.OP_CHECKSIG, txscript.OP_FALSE, txscript.OP_IF)
+	script = pushData(script, protocolID)
+	field := func(tag byte, value []byte) {
+		script = pushData(script, []byte{tag})
+		script = pushData(script, value)
+	}
+	if inscription.ContentType != "" {
+		field(tagContentType, []byte(inscription.ContentType))
+	}
+	if inscription.ContentEncoding != "" {
+		field(tagContentEncoding, []byte(inscription.ContentEncoding))
+	}
+	if inscription.Metaprotocol != "" {
+		field(tagMetaprotocol, []byte(inscription.Metaprotocol))
+	}
+	for _, parent := range inscription.Parents {
+		field(tagParent, encodeInscriptionID(parent))
+	}
+	if inscription.Pointer != nil {
+		field(tagPointer, encodeLittleEndian(*inscription.Pointer))
+	}
+	for _, chunk := range chunks(inscription.Metadata) {
+		field(tagMetadata, chunk)
+	}
+	if inscription.Body != nil {
+		script = append(script, txscript.OP_0)
+		for _, chunk := range chunks(inscription.Body) {
+			script = pushData(script, chunk)
+		}
+	}
+	return append(script, txscript.OP_ENDIF)
+}
+
+// Inscribe builds and signs the commit and reveal transactions of the inscription
+func (i *Inscriber) Inscribe(params InscribeParams, available []bitcoin.UTXO) (*CommitReveal, error) {
+	if params.FeeRate <= 0 {
+		return nil, bitcoin.ErrInvalidFeeRate
+	}
+	postage := params.Postage
+	if postage == 0 {
+		postage = DefaultPostage
+	}
+	if postage < bitcoin.DustLimit {
+		return nil, fmt.Errorf("%w: %d sats", bitcoin.ErrDustOutput, postage)
+	}
+	privateKey, publicKey := btcec.PrivKeyFromBytes(i.keypair.GetSecretKey())
+
+	destination, err := bitcoin.P2TRScript(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	if params.Destination != "" {
+		addr, err := address.NewBitcoinAddress(params.Destination, i.network)
+		if err != nil {
+			return nil, err
+		}
+		if destination, err = addr.ScriptPubKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	// The commit output is tweaked with the single leaf tree of the reveal script
+	revealScript := RevealScript(publicKey, &params.Inscription)
+	tapLeaf := txscript.NewBaseTapLeaf(revealScript)
+	tree := txscript.AssembleTaprootScriptTree(tapLeaf)
+	rootHash := tree.RootNode.TapHash()
+	commitScript, err := txscript.PayToTaprootScript(txscript.ComputeTaprootOutputKey(publicKey, rootHash[:]))
+	if err != nil {
+		return nil, err
+	}
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(publicKey)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	reveal := wire.NewMsgTx(2)
+	revealIn := wire.NewTxIn(&wire.OutPoint{}, nil, nil)
+	revealIn.Sequence = bitcoin.DefaultSequence
+	reveal.AddTxIn(revealIn)
+	reveal.AddTxOut(wire.NewTxOut(postage, destination))
+	// The Schnorr signature with SIGHASH_DEFAULT is always 64 bytes, the size of the reveal is exact
+	revealIn.Witness = wire.TxWitness{make([]byte, schnorr.SignatureSize), revealScript, controlBlockBytes}
+	revealFee := bitcoin.FeeForVSize(virtualSize(reveal), params.FeeRate)
+
+	builder := bitcoin.NewTransactionBuilder(i.network).SetFeeRate(params.FeeRate)
+	if err := builder.AddOutputScript(commitScript, postage+revealFee); err != nil {
+		return nil, err
+	}
+	if params.ChangeAddress != "" {
+		if err := builder.SetChangeAddress(params.ChangeAddress); err != nil {
+			return nil, err
+		}
+	}
+	built, err := builder.Build(available)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := bitcoin.Sign(built.Packet, i.keypair); err != nil {
+		return nil, err
+	}
+	commit, err := bitcoin.Finalize(built.Packet)
+	if err != nil {
+		return nil, err
+	}
+
+	// The commit output is the first output of the commit transaction
+	commitOut := commit.TxOut[0]
+	revealIn.PreviousOutPoint = wire.OutPoint{Hash: commit.TxHash(), Index: 0}
+	prevOuts := txscript.NewCannedPrevOutputFetcher(commitOut.PkScript, commitOut.Value)
+	sigHashes := txscript.NewTxSigHashes(reveal, prevOuts)
+	signature, err := txscript.RawTxInTapscriptSignature(reveal, sigHashes, 0, commitOut.Value, commitOut.PkScript, tapLeaf, txscript.SigHashDefault, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	revealIn.Witness[0] = signature
+
+	return &CommitReveal{
+		Commit:        commit,
+		Reveal:        reveal,
+		CommitFee:     built.Fee,
+		RevealFee:     revealFee,
+		RevealScript:  revealScript,
+		InscriptionID: types.InscriptionID{Txid: types.RoochAddress(reveal.TxHash()), Index: 0},
+	}, nil
+}
+
+// virtualSize is the virtual size in vbytes of the transaction with its witnesses
+func virtualSize(tx *wire.MsgTx) int64 {
+	weight := int64(tx.SerializeSizeStripped()*3 + tx.SerializeSize())
+	return (weight + 3) / 4
+}
+
+// chunks splits data in pushes of at most maxPushSize bytes
+func chunks(data []byte) [][]byte {
+	var result [][]byte
+	for len(data) > 0 {
+		size := min(len(data), maxPushSize)
+		result = append(result, data[:size])
+		data = data[size:]
+	}
+	return result
+}
+
+// pushData appends the push of data to the script. The script builder of btcd is not used as it
+// limits the script to the 10000 bytes of a legacy script, the reveal script has no such limit
+func pushData(script []byte, data []byte) []byte {
+	switch size := len(data); {
+	case size == 0:
+		return append(script, txscript.OP_0)
+	case size < txscript.OP_PUSHDATA1:
+		script = append(script, byte(size))
+	case size <= 0xff:
+		script = append(script, txscript.OP_PUSHDATA1, byte(size))
+	case size <= 0xffff:
+		script = append(script, txscript.OP_PUSHDATA2)
+		script = binary.LittleEndian.AppendUint16(script, uint16(size))
+	default:
+		script = append(script, txscript.OP_PUSHDATA4)
+		script = binary.LittleEndian.AppendUint32(script, uint32(size))
+	}
+	return append(script, data...)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package ordinals
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/bitcoin"
+	"github.com/rooch-network/rooch-go-sdk/keypairs/secp256k1"
+	"github.com/rooch-network/rooch-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testKeypair(t *testing.T) (*secp256k1.Secp256k1Keypair, *btcec.PublicKey) {
+	keypair, err := secp256k1.FromSecp256k1SecretKey(bytes.Repeat([]byte{0x2b}, 32), false)
+	assert.NoError(t, err)
+	_, publicKey := btcec.PrivKeyFromBytes(keypair.GetSecretKey())
+	return keypair, publicKey
+}
+
+func TestRevealScript(t *testing.T) {
+	_, publicKey := testKeypair(t)
+	pointer := uint64(1000)
+	parent := types.InscriptionID{Txid: types.RoochAddress{1, 2, 3}, Index: 2}
+	inscription := Inscription{
+		ContentType:     "text/plain;charset=utf-8",
+		ContentEncoding: "br",
+		Metaprotocol:    "bitseed",
+		Metadata:        bytes.Repeat([]byte{0xa1}, 600),
+		Parents:         []types.InscriptionID{parent, {Txid: types.RoochAddress{4}}},
+		Pointer:         &pointer,
+		Body:            bytes.Repeat([]byte("rooch"), 300),
+	}
+	script := RevealScript(publicKey, &inscription)
+	parsed := ParseScript(script)
+	assert.Len(t, parsed, 1)
+	assert.Equal(t, inscription, parsed[0])
+
+	t.Run("empty body", func(t *testing.T) {
+		parsed := ParseScript(RevealScript(publicKey, &Inscription{ContentType: "text/plain"}))
+		assert.Equal(t, []Inscription{{ContentType: "text/plain"}}, parsed)
+	})
+	t.Run("several envelopes", func(t *testing.T) {
+		second := RevealScript(publicKey, &Inscription{Body: []byte("second")})
+		// The envelope of the second script follows the first, without its checksig
+		parsed := ParseScript(append(append([]byte{}, script...), second[33+1:]...))
+		assert.Len(t, parsed, 2)
+		assert.Equal(t, []byte("second"), parsed[1].Body)
+	})
+	t.Run("envelope with an opcode", func(t *testing.T) {
+		invalid := []byte{txscript.OP_FALSE, txscript.OP_IF, 3, 'o', 'r', 'd', txscript.OP_CHECKSIG, txscript.OP_ENDIF}
+		assert.Empty(t, ParseScript(invalid))
+	})
+	t.Run("pushnum tag", func(t *testing.T) {
+		script := []byte{txscript.OP_FALSE, txscript.OP_IF, 3, 'o', 'r', 'd', txscript.OP_1, 4, 't', 'e', 'x', 't', txscript.OP_ENDIF}
+		assert.Equal(t, []Inscription{{ContentType: "text"}}, ParseScript(script))
+	})
+	t.Run("witness", func(t *testing.T) {
+		controlBlock := make([]byte, 33)
+		assert.Len(t, ParseWitness(wire.TxWitness{make([]byte, 64), script, controlBlock}), 1)
+		assert.Len(t, ParseWitness(wire.TxWitness{make([]byte, 64), script, controlBlock, {0x50, 1}}), 1)
+		assert.Empty(t, ParseWitness(wire.TxWitness{make([]byte, 64)}))
+	})
+}
+
+func TestInscribe(t *testing.T) {
+	keypair, publicKey := testKeypair(t)
+	p2tr, err := bitcoin.P2TRScript(publicKey)
+	assert.NoError(t, err)
+	available := []bitcoin.UTXO{{OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}}, Value: 100000, PkScript: p2tr}}
+
+	inscriber := NewInscriber(address.BitcoinNetworkRegtest, keypair)
+	inscription := Inscription{ContentType: "text/plain;charset=utf-8", Body: []byte("Hello, Rooch!")}
+	built, err := inscriber.Inscribe(InscribeParams{Inscription: inscription, FeeRate: 3}, available)
+	assert.NoError(t, err)
+
+	commitOut := built.Commit.TxOut[0]
+	assert.Equal(t, DefaultPostage+built.RevealFee, commitOut.Value)
+	assert.Equal(t, built.Commit.TxHash(), built.Reveal.TxIn[0].PreviousOutPoint.Hash)
+	assert.Equal(t, p2tr, built.Reveal.TxOut[0].PkScript)
+	assert.Equal(t, int64(DefaultPostage), built.Reveal.TxOut[0].Value)
+	assert.Equal(t, bitcoin.FeeForVSize(virtualSize(built.Reveal), 3), built.RevealFee)
+
+	// Both transactions must pass the script engine
+	verify := func(tx *wire.MsgTx, prevOut *wire.TxOut) {
+		prevOuts := txscript.NewCannedPrevOutputFetcher(prevOut.PkScript, prevOut.Value)
+		engine, err := txscript.NewEngine(prevOut.PkScript, tx, 0, txscript.StandardVerifyFlags, nil, txscript.NewTxSigHashes(tx, prevOuts), prevOut.Value, prevOuts)
+		assert.NoError(t, err)
+		assert.NoError(t, engine.Execute())
+	}
+	verify(built.Commit, available[0].TxOut())
+	verify(built.Reveal, commitOut)
+
+	envelopes := ParseTransaction(built.Reveal)
+	assert.Len(t, envelopes, 1)
+	assert.Equal(t, inscription, envelopes[0].Inscription)
+	assert.Equal(t, built.InscriptionID, envelopes[0].ID)
+	assert.Equal(t, built.Reveal.TxHash().String()+"i0", built.InscriptionID.String())
+
+	t.Run("dust postage", func(t *testing.T) {
+		_, err := inscriber.Inscribe(InscribeParams{Inscription: inscription, FeeRate: 1, Postage: 100}, available)
+		assert.ErrorIs(t, err, bitcoin.ErrDustOutput)
+	})
+	t.Run("insufficient funds", func(t *testing.T) {
+		_, err := inscriber.Inscribe(InscribeParams{Inscription: inscription, FeeRate: 1, Postage: 200000}, available)
+		assert.ErrorIs(t, err, bitcoin.ErrInsufficientFunds)
+	})
+}
+
+func TestInscriptionObjectID(t *testing.T) {
+	inscriptionID := "6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799i0"
+	objectID, err := InscriptionObjectID(inscriptionID)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x085d7fa27c08c31c4d9dfdb7e6a1f3020850b8d28a1d11f50164f080b77b5f34", objectID.String())
+	id, err := types.ParseInscriptionID(inscriptionID)
+	assert.NoError(t, err)
+
+	inscription := &types.Inscription{ID: id}
+	value, err := bcs.Serialize(inscription)
+	assert.NoError(t, err)
+	state := &types.ObjectStateView{ObjectState: types.ObjectState{
+		Metadata: types.ObjectMeta{ID: objectID, ObjectType: *types.TypeTagFromStructTag(&types.InscriptionStructTag)},
+		Value:    value,
+	}}
+	back, err := InscriptionIDFromObject(state)
+	assert.NoError(t, err)
+	assert.Equal(t, inscriptionID, back.String())
+
+	t.Run("other object", func(t *testing.T) {
+		state.Metadata.ID = types.NewObjectID([]types.RoochAddress{types.AddressFour})
+		_, err := InscriptionIDFromObject(state)
+		assert.ErrorIs(t, err, ErrNotInscriptionObject)
+	})
+	_, err = InscriptionObjectID("not an id")
+	assert.ErrorIs(t, err, types.ErrInvalidInscriptionID)
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+var ErrInvalidAccumulatorProof = errors.New("invalid accumulator proof")
+
+// MaxAccumulatorProofDepth is the maximum number of siblings of an [AccumulatorProof], the accumulator has at most 2^63 leaves
+const MaxAccumulatorProofDepth = 63
+
+// AccumulatorPlaceholderHash is the hash of the empty subtrees on the right of the accumulator, the
+// literal ACCUMULATOR_PLACEHOLDER_HASH padded with zeros
+var AccumulatorPlaceholderHash = func() H256 {
+	var h H256
+	copy(h[:], "ACCUMULATOR_PLACEHOLDER_HASH")
+	return h
+}()
+
+// AccumulatorInternalHash is the hash of an internal node of the Merkle accumulator, sha3_256(left || right)
+func AccumulatorInternalHash(left, right H256) H256 {
+	var h H256
+	copy(h[:], utils.Sha3256(append(left.Bytes(), right.Bytes()...)))
+	return h
+}
+
+//pub struct AccumulatorProof {
+//pub siblings: Vec<H256>,
+//}
+
+// AccumulatorProof is the inclusion proof of a leaf in the Merkle accumulator, the siblings are
+// ordered from the leaf to the root
+type AccumulatorProof struct {
+	Siblings []H256 `json:"siblings"`
+}
+
+func (p *AccumulatorProof) MarshalBCS(ser *bcs.Serializer) {
+	bcs.SerializeSequence(p.Siblings, ser)
+}
+func (p *AccumulatorProof) UnmarshalBCS(des *bcs.Deserializer) {
+	p.Siblings = bcs.DeserializeSequence[H256](des)
+}
+
+// RootHash computes the root of the accumulator from the leaf at leafIndex and the siblings, the
+// index must be one of the 2^len(Siblings) leaves of the proof
+func (p *AccumulatorProof) RootHash(leafHash H256, leafIndex uint64) (H256, error) {
+	if len(p.Siblings) > MaxAccumulatorProofDepth {
+		return H256{}, fmt.Errorf("%w: %d siblings is more than the max depth %d", ErrInvalidAccumulatorProof, len(p.Siblings), MaxAccumulatorProofDepth)
+	}
+	if leafIndex>>len(p.Siblings) != 0 {
+		return H256{}, fmt.Errorf("%w: leaf %d is out of a proof of %d siblings", ErrInvalidAccumulatorProof, leafIndex, len(p.Siblings))
+	}
+	hash, index := leafHash, leafIndex
+	for _, sibling := range p.Siblings {
+		if index%2 == 0 {
+			hash = AccumulatorInternalHash(hash, sibling)
+		} else {
+			hash = AccumulatorInternalHash(sibling, hash)
+		}
+		index /= 2
+	}
+	return hash, nil
+}
+
+// Verify checks the proof shows the leaf at leafIndex is in the accumulator with the expected root,
+// e.g. a transaction hash at its tx order under a trusted tx accumulator root
+func (p *AccumulatorProof) Verify(expectedRoot H256, leafHash H256, leafIndex uint64) error {
+	root, err := p.RootHash(leafHash, leafIndex)
+	if err != nil {
+		return err
+	}
+	if root != expectedRoot {
+		return fmt.Errorf("%w: root %s of leaf %d does not match the expected root %s", ErrInvalidAccumulatorProof, root, leafIndex, expectedRoot)
+	}
+	return nil
+}
+
+//pub struct AccumulatorInfo {
+///// Accumulator root hash
+//pub accumulator_root: H256,
+///// Frozen subtree roots of this accumulator.
+//pub frozen_subtree_roots: Vec<H256>,
+///// The total number of leaves in this accumulator.
+//pub num_leaves: u64,
+///// The total number of nodes in this accumulator.
+//pub num_nodes: u64,
+//}
+
+// AccumulatorInfo is the state of a Merkle accumulator
+type AccumulatorInfo struct {
+	AccumulatorRoot    H256   `json:"accumulator_root"`
+	FrozenSubtreeRoots []H256 `json:"frozen_subtree_roots"`
+	NumLeaves          uint64 `json:"num_leaves"`
+	NumNodes           uint64 `json:"num_nodes"`
+}
+
+func (ai *AccumulatorInfo) MarshalBCS(ser *bcs.Serializer) {
+	ai.AccumulatorRoot.MarshalBCS(ser)
+	bcs.SerializeSequence(ai.FrozenSubtreeRoots, ser)
+	ser.U64(ai.NumLeaves)
+	ser.U64(ai.NumNodes)
+}
+func (ai *AccumulatorInfo) UnmarshalBCS(des *bcs.Deserializer) {
+	ai.AccumulatorRoot.UnmarshalBCS(des)
+	ai.FrozenSubtreeRoots = bcs.DeserializeSequence[H256](des)
+	ai.NumLeaves = des.U64()
+	ai.NumNodes = des.U64()
+}
+
+// UnmarshalJSON converts the AccumulatorInfo from the AccumulatorInfoView of the RPC
+func (ai *AccumulatorInfo) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		AccumulatorRoot    H256   `json:"accumulator_root"`
+		FrozenSubtreeRoots []H256 `json:"frozen_subtree_roots"`
+		NumLeaves          strU64 `json:"num_leaves"`
+		NumNodes           strU64 `json:"num_nodes"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	ai.AccumulatorRoot = data.AccumulatorRoot
+	ai.FrozenSubtreeRoots = data.FrozenSubtreeRoots
+	ai.NumLeaves = uint64(data.NumLeaves)
+	ai.NumNodes = uint64(data.NumNodes)
+	return nil
+}
+
+// ComputeRootHash computes the root of the accumulator from its frozen subtree roots, the subtrees
+// on the right of the last frozen subtree are placeholders
+func (ai *AccumulatorInfo) ComputeRootHash() (H256, error) {
+	if len(ai.FrozenSubtreeRoots) != bits.OnesCount64(ai.NumLeaves) {
+		return H256{}, fmt.Errorf("%w: %d frozen subtree roots for %d leaves", ErrInvalidAccumulatorProof, len(ai.FrozenSubtreeRoots), ai.NumLeaves)
+	}
+	switch len(ai.FrozenSubtreeRoots) {
+	case 0:
+		return AccumulatorPlaceholderHash, nil
+	case 1:
+		return ai.FrozenSubtreeRoots[0], nil
+	}
+
+	// The bits of the number of leaves are the frozen subtrees, from the smallest on the right
+	bitmap := ai.NumLeaves >> bits.TrailingZeros64(ai.NumLeaves)
+	current := AccumulatorPlaceholderHash
+	next := len(ai.FrozenSubtreeRoots) - 1
+	for bitmap > 0 {
+		if bitmap&1 != 0 {
+			current = AccumulatorInternalHash(ai.FrozenSubtreeRoots[next], current)
+			next--
+		} else {
+			current = AccumulatorInternalHash(current, AccumulatorPlaceholderHash)
+		}
+		bitmap >>= 1
+	}
+	return current, nil
+}
+
+// Verify checks the accumulator root is the root of the frozen subtrees
+func (ai *AccumulatorInfo) Verify() error {
+	root, err := ai.ComputeRootHash()
+	if err != nil {
+		return err
+	}
+	if root != ai.AccumulatorRoot {
+		return fmt.Errorf("%w: frozen subtrees root %s does not match the accumulator root %s", ErrInvalidAccumulatorProof, root, ai.AccumulatorRoot)
+	}
+	return nil
+}
+
+// VerifyProof checks the proof shows the leaf at leafIndex is in the accumulator, the leaf must be one of
+// its NumLeaves leaves and the proof as deep as the accumulator
+func (ai *AccumulatorInfo) VerifyProof(proof *AccumulatorProof, leafHash H256, leafIndex uint64) error {
+	if leafIndex >= ai.NumLeaves {
+		return fmt.Errorf("%w: leaf %d is out of the %d leaves of the accumulator", ErrInvalidAccumulatorProof, leafIndex, ai.NumLeaves)
+	}
+	if depth := bits.Len64(ai.NumLeaves - 1); len(proof.Siblings) != depth {
+		return fmt.Errorf("%w: %d siblings for an accumulator of depth %d", ErrInvalidAccumulatorProof, len(proof.Siblings), depth)
+	}
+	return proof.Verify(ai.AccumulatorRoot, leafHash, leafIndex)
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testAccumulatorRoot is the root of the accumulator of leaves in a tree of size leaves, empty
+// subtrees are placeholders
+func testAccumulatorRoot(leaves []H256, size uint64) H256 {
+	if len(leaves) == 0 {
+		return AccumulatorPlaceholderHash
+	}
+	if size == 1 {
+		return leaves[0]
+	}
+	half := size / 2
+	if uint64(len(leaves)) <= half {
+		return AccumulatorInternalHash(testAccumulatorRoot(leaves, half), AccumulatorPlaceholderHash)
+	}
+	return AccumulatorInternalHash(testAccumulatorRoot(leaves[:half], half), testAccumulatorRoot(leaves[half:], half))
+}
+
+func testAccumulatorProof(leaves []H256, size uint64, index uint64) []H256 {
+	if size == 1 {
+		return nil
+	}
+	half := size / 2
+	if index < half {
+		right := AccumulatorPlaceholderHash
+		if uint64(len(leaves)) > half {
+			right = testAccumulatorRoot(leaves[half:], half)
+		}
+		return append(testAccumulatorProof(leaves[:min(uint64(len(leaves)), half)], half, index), right)
+	}
+	return append(testAccumulatorProof(leaves[half:], half, index-half), testAccumulatorRoot(leaves[:half], half))
+}
+
+func testAccumulator(numLeaves uint64) ([]H256, uint64, AccumulatorInfo) {
+	leaves := make([]H256, numLeaves)
+	for i := range leaves {
+		leaves[i] = testH256(byte(i + 1))
+	}
+	size := uint64(1)
+	for size < numLeaves {
+		size *= 2
+	}
+	info := AccumulatorInfo{AccumulatorRoot: testAccumulatorRoot(leaves, size), NumLeaves: numLeaves}
+	// The frozen subtrees are the complete subtrees, from the largest on the left
+	start := uint64(0)
+	for bit := uint64(1) << 63; bit > 0; bit >>= 1 {
+		if numLeaves&bit != 0 {
+			info.FrozenSubtreeRoots = append(info.FrozenSubtreeRoots, testAccumulatorRoot(leaves[start:start+bit], bit))
+			start += bit
+		}
+	}
+	return leaves, size, info
+}
+
+func TestAccumulatorInfo(t *testing.T) {
+	for numLeaves := uint64(0); numLeaves <= 13; numLeaves++ {
+		_, _, info := testAccumulator(numLeaves)
+		root, err := info.ComputeRootHash()
+		assert.NoError(t, err)
+		assert.Equal(t, info.AccumulatorRoot, root, "%d leaves", numLeaves)
+		assert.NoError(t, info.Verify())
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, _, info := testAccumulator(6)
+		info.AccumulatorRoot = testH256(0xff)
+		assert.ErrorIs(t, info.Verify(), ErrInvalidAccumulatorProof)
+
+		_, _, info = testAccumulator(6)
+		info.NumLeaves = 7
+		assert.ErrorIs(t, info.Verify(), ErrInvalidAccumulatorProof)
+	})
+}
+
+func TestAccumulatorProof(t *testing.T) {
+	leaves, size, info := testAccumulator(11)
+	for i := range leaves {
+		proof := &AccumulatorProof{Siblings: testAccumulatorProof(leaves, size, uint64(i))}
+		assert.NoError(t, proof.Verify(info.AccumulatorRoot, leaves[i], uint64(i)))
+		assert.ErrorIs(t, proof.Verify(info.AccumulatorRoot, leaves[i], uint64(i)^1), ErrInvalidAccumulatorProof)
+		assert.ErrorIs(t, proof.Verify(info.AccumulatorRoot, testH256(0xff), uint64(i)), ErrInvalidAccumulatorProof)
+	}
+
+	t.Run("too deep", func(t *testing.T) {
+		proof := &AccumulatorProof{Siblings: make([]H256, MaxAccumulatorProofDepth+1)}
+		assert.ErrorIs(t, proof.Verify(info.AccumulatorRoot, leaves[0], 0), ErrInvalidAccumulatorProof)
+	})
+
+	t.Run("index out of the proof", func(t *testing.T) {
+		// The high bits of the index are not used by the siblings, the proof of leaf 3 is not one of leaf 3+16
+		proof := &AccumulatorProof{Siblings: testAccumulatorProof(leaves, size, 3)}
+		assert.NoError(t, proof.Verify(info.AccumulatorRoot, leaves[3], 3))
+		assert.ErrorIs(t, proof.Verify(info.AccumulatorRoot, leaves[3], 3+size), ErrInvalidAccumulatorProof)
+	})
+
+	t.Run("accumulator leaves", func(t *testing.T) {
+		for i := range leaves {
+			proof := &AccumulatorProof{Siblings: testAccumulatorProof(leaves, size, uint64(i))}
+			assert.NoError(t, info.VerifyProof(proof, leaves[i], uint64(i)))
+		}
+		// Leaf 11 would be a placeholder of the tree of 16 leaves
+		proof := &AccumulatorProof{Siblings: testAccumulatorProof(leaves, size, 11)}
+		assert.ErrorIs(t, info.VerifyProof(proof, AccumulatorPlaceholderHash, 11), ErrInvalidAccumulatorProof)
+		proof = &AccumulatorProof{Siblings: testAccumulatorProof(leaves, size, 2)[:3]}
+		assert.ErrorIs(t, info.VerifyProof(proof, leaves[2], 2), ErrInvalidAccumulatorProof)
+	})
+}
+
+func TestAccumulatorVector(t *testing.T) {
+	// The accumulator of the 5 leaves sha3_256([i]), computed with Python hashlib independently of the
+	// helpers above
+	h := func(input string) H256 {
+		hash, err := ParseH256(input)
+		assert.NoError(t, err)
+		return hash
+	}
+	leaves := []H256{
+		h("0x5d53469f20fef4f8eab52b88044ede69c77a6a68a60728609fc4a65ff531e7d0"),
+		h("0x2767f15c8af2f2c7225d5273fdd683edc714110a987d1054697c348aed4e6cc7"),
+		h("0x0a1e2736777f80a62beb2df72b649878481c0ca10194b832b5136befbae54017"),
+		h("0xe3ed56bd086d8958483a12734fa0ae7f5c8bb160ef9092c67e82ed9b19e4c7b2"),
+		h("0x989216075a288af2c12f115557518d248f93c434965513f5f739df8c9d6e1932"),
+	}
+	info := AccumulatorInfo{
+		AccumulatorRoot: h("0x38ca5d64a481d73a2dcc2470dd3e1a3fd6104c82387375c7d6c235e9249af8d2"),
+		FrozenSubtreeRoots: []H256{
+			h("0xe349c4a7f57723a42f0869723644e28b2c0b03bb59585fc4765bebd708f19526"),
+			leaves[4],
+		},
+		NumLeaves: 5,
+		NumNodes:  8,
+	}
+	assert.NoError(t, info.Verify())
+
+	proof := &AccumulatorProof{Siblings: []H256{
+		leaves[3],
+		h("0xd9a58c55807dd1bd547132f162bb15314b2d185c828ae604c53e6eaf705a071c"),
+		h("0x19e68fb6e6e2e0d4fcfb96436b956a18ba37e61dd463d118648d6b1aee99ccb3"),
+	}}
+	assert.NoError(t, info.VerifyProof(proof, leaves[2], 2))
+
+	proof = &AccumulatorProof{Siblings: []H256{
+		AccumulatorPlaceholderHash,
+		AccumulatorPlaceholderHash,
+		info.FrozenSubtreeRoots[0],
+	}}
+	assert.NoError(t, info.VerifyProof(proof, leaves[4], 4))
+	assert.ErrorIs(t, info.VerifyProof(proof, leaves[4], 5), ErrInvalidAccumulatorProof)
+}
@@ -8,7 +8,7 @@ import (
 
 type RoochAddress = address.RoochAddress
 
-// AccountAddress is a 32 byte address on the Rooch blockchain
+// AccountAddress is a 32 byte address on the Rooch blockchain, the same type as [RoochAddress]
 // It can represent an Object, an Account, and much more.
 type AccountAddress = address.AccountAddress
 
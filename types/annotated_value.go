@@ -0,0 +1,81 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+// unmarshalAnnotatedStruct converts the fields of a Move struct into v, the input is either an
+// AnnotatedMoveStructView {"abilities", "type", "value"} as returned in decoded values, or the
+// bare fields
+func unmarshalAnnotatedStruct(b []byte, v any) error {
+	var view struct {
+		Abilities json.RawMessage `json:"abilities"`
+		Type      *string         `json:"type"`
+		Value     json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(b, &view); err != nil {
+		return err
+	}
+	if view.Abilities != nil && view.Type != nil && bytes.HasPrefix(bytes.TrimSpace(view.Value), []byte("{")) {
+		b = view.Value
+	}
+	return json.Unmarshal(b, v)
+}
+
+// strBigInt is a u128 or u256 encoded as a decimal string in the RPC views
+type strBigInt big.Int
+
+func (u *strBigInt) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		// Small values may be plain numbers
+		str = string(b)
+	}
+	value, err := utils.StrToBigInt(str)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s: %w", str, err)
+	}
+	*u = strBigInt(*value)
+	return nil
+}
+
+func (u *strBigInt) BigInt() *big.Int {
+	return (*big.Int)(u)
+}
+
+// moveOption is a Move Option<T> in the RPC views, either the value, null, or the
+// {"vec": [value]} form of the Option struct
+type moveOption[T any] struct {
+	Value *T
+}
+
+func (o *moveOption[T]) UnmarshalJSON(b []byte) error {
+	o.Value = nil
+	if string(bytes.TrimSpace(b)) == "null" {
+		return nil
+	}
+	var vec struct {
+		Vec *[]json.RawMessage `json:"vec"`
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) && unmarshalAnnotatedStruct(b, &vec) == nil && vec.Vec != nil {
+		switch len(*vec.Vec) {
+		case 0:
+			return nil
+		case 1:
+			b = (*vec.Vec)[0]
+		default:
+			return fmt.Errorf("invalid option with %d values", len(*vec.Vec))
+		}
+	}
+	value := new(T)
+	if err := json.Unmarshal(b, value); err != nil {
+		return err
+	}
+	o.Value = value
+	return nil
+}
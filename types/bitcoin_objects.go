@@ -0,0 +1,454 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+)
+
+var ErrInvalidOutPoint = errors.New("invalid outpoint")
+var ErrInvalidInscriptionID = errors.New("invalid inscription id")
+
+// UTXOStructTag is the type of the 0x4::utxo::UTXO objects
+var UTXOStructTag = StructTag{Address: AddressFour, Module: "utxo", Name: "UTXO"}
+
+// InscriptionStructTag is the type of the 0x4::ord::Inscription objects
+var InscriptionStructTag = StructTag{Address: AddressFour, Module: "ord", Name: "Inscription"}
+
+//struct OutPoint has store, copy, drop {
+//txid: address,
+//vout: u32,
+//}
+
+// OutPoint is a 0x4::types::OutPoint, the txid is stored as a Move address in the internal byte order
+type OutPoint struct {
+	Txid RoochAddress `json:"txid"`
+	Vout uint32       `json:"vout"`
+}
+
+func (op *OutPoint) MarshalBCS(ser *bcs.Serializer) {
+	op.Txid.MarshalBCS(ser)
+	ser.U32(op.Vout)
+}
+func (op *OutPoint) UnmarshalBCS(des *bcs.Deserializer) {
+	op.Txid.UnmarshalBCS(des)
+	op.Vout = des.U32()
+}
+
+// UnmarshalJSON converts the OutPoint from its annotated Move struct
+func (op *OutPoint) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		Txid RoochAddress `json:"txid"`
+		Vout uint32       `json:"vout"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to OutPoint: %w", err)
+	}
+	op.Txid = data.Txid
+	op.Vout = data.Vout
+	return nil
+}
+
+// String returns the outpoint as txid:vout, the txid in the display byte order
+func (op *OutPoint) String() string {
+	return txidString(op.Txid) + ":" + strconv.FormatUint(uint64(op.Vout), 10)
+}
+
+// ObjectID returns the ID of the UTXO object of the output, a custom object ID of the outpoint
+func (op *OutPoint) ObjectID() (ObjectID, error) {
+	idBcs, err := bcs.Serialize(op)
+	if err != nil {
+		return ObjectID{}, err
+	}
+	return CustomObjectID(idBcs, &UTXOStructTag), nil
+}
+
+// ParseOutPoint parses an outpoint as txid:vout, e.g. 4a5e1e4b...da33b:0
+func ParseOutPoint(input string) (OutPoint, error) {
+	sep := strings.LastIndex(input, ":")
+	if sep < 0 {
+		return OutPoint{}, fmt.Errorf("%w: %s", ErrInvalidOutPoint, input)
+	}
+	txid, err := parseTxid(input[:sep])
+	if err != nil {
+		return OutPoint{}, fmt.Errorf("%w: %s: %w", ErrInvalidOutPoint, input, err)
+	}
+	vout, err := strconv.ParseUint(input[sep+1:], 10, 32)
+	if err != nil {
+		return OutPoint{}, fmt.Errorf("%w: %s: %w", ErrInvalidOutPoint, input, err)
+	}
+	return OutPoint{Txid: txid, Vout: uint32(vout)}, nil
+}
+
+//struct SatPoint has store, copy, drop {
+//outpoint: OutPoint,
+//offset: u64,
+//}
+
+// SatPoint is a 0x4::ord::SatPoint, the location of a sat at offset in the output
+type SatPoint struct {
+	OutPoint OutPoint `json:"outpoint"`
+	Offset   uint64   `json:"offset"`
+}
+
+func (sp *SatPoint) MarshalBCS(ser *bcs.Serializer) {
+	sp.OutPoint.MarshalBCS(ser)
+	ser.U64(sp.Offset)
+}
+func (sp *SatPoint) UnmarshalBCS(des *bcs.Deserializer) {
+	sp.OutPoint.UnmarshalBCS(des)
+	sp.Offset = des.U64()
+}
+
+// UnmarshalJSON converts the SatPoint from its annotated Move struct
+func (sp *SatPoint) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		OutPoint OutPoint `json:"outpoint"`
+		Offset   strU64   `json:"offset"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to SatPoint: %w", err)
+	}
+	sp.OutPoint = data.OutPoint
+	sp.Offset = uint64(data.Offset)
+	return nil
+}
+
+//struct InscriptionID has store, copy, drop {
+//txid: address,
+//index: u32,
+//}
+
+// InscriptionID is a 0x4::ord::InscriptionID, the reveal txid and the index of the inscription in it
+type InscriptionID struct {
+	Txid  RoochAddress `json:"txid"`
+	Index uint32       `json:"index"`
+}
+
+func (id *InscriptionID) MarshalBCS(ser *bcs.Serializer) {
+	id.Txid.MarshalBCS(ser)
+	ser.U32(id.Index)
+}
+func (id *InscriptionID) UnmarshalBCS(des *bcs.Deserializer) {
+	id.Txid.UnmarshalBCS(des)
+	id.Index = des.U32()
+}
+
+// UnmarshalJSON converts the InscriptionID from its annotated Move struct
+func (id *InscriptionID) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		Txid  RoochAddress `json:"txid"`
+		Index uint32       `json:"index"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to InscriptionID: %w", err)
+	}
+	id.Txid = data.Txid
+	id.Index = data.Index
+	return nil
+}
+
+// String returns the ord form of the inscription ID, the txid in the display byte order, `i` and the index
+func (id *InscriptionID) String() string {
+	return txidString(id.Txid) + "i" + strconv.FormatUint(uint64(id.Index), 10)
+}
+
+// ObjectID returns the ID of the Inscription object of the inscription, a custom object ID of the inscription ID
+func (id *InscriptionID) ObjectID() (ObjectID, error) {
+	idBcs, err := bcs.Serialize(id)
+	if err != nil {
+		return ObjectID{}, err
+	}
+	return CustomObjectID(idBcs, &InscriptionStructTag), nil
+}
+
+// ParseInscriptionID parses the ord form of an inscription ID, e.g. 6fb976ab...a3b4i0
+func ParseInscriptionID(input string) (InscriptionID, error) {
+	sep := strings.LastIndex(input, "i")
+	if sep < 0 {
+		return InscriptionID{}, fmt.Errorf("%w: %s", ErrInvalidInscriptionID, input)
+	}
+	txid, err := parseTxid(input[:sep])
+	if err != nil {
+		return InscriptionID{}, fmt.Errorf("%w: %s: %w", ErrInvalidInscriptionID, input, err)
+	}
+	index, err := strconv.ParseUint(input[sep+1:], 10, 32)
+	if err != nil {
+		return InscriptionID{}, fmt.Errorf("%w: %s: %w", ErrInvalidInscriptionID, input, err)
+	}
+	return InscriptionID{Txid: txid, Index: uint32(index)}, nil
+}
+
+// txidString returns the hex of a txid in the display byte order, the reverse of the internal byte order
+func txidString(txid RoochAddress) string {
+	reversed := make([]byte, len(txid))
+	for i, b := range txid {
+		reversed[len(txid)-1-i] = b
+	}
+	return hex.EncodeToString(reversed)
+}
+
+// parseTxid parses the hex of a txid in the display byte order
+func parseTxid(input string) (RoochAddress, error) {
+	var txid RoochAddress
+	decoded, err := hex.DecodeString(input)
+	if err != nil {
+		return txid, err
+	}
+	if len(decoded) != len(txid) {
+		return txid, fmt.Errorf("txid must be %d bytes, got %d", len(txid), len(decoded))
+	}
+	for i, b := range decoded {
+		txid[len(txid)-1-i] = b
+	}
+	return txid, nil
+}
+
+//struct UTXO has key {
+//txid: address,
+//vout: u32,
+//value: u64,
+//seals: SimpleMultiMap<String, ObjectID>,
+//}
+
+// UTXO is a 0x4::utxo::UTXO object, Seals are the entries of the SimpleMultiMap from a protocol type to
+// the IDs of the objects sealed in the output, in their order on chain
+type UTXO struct {
+	Txid  RoochAddress `json:"txid"`
+	Vout  uint32       `json:"vout"`
+	Value uint64       `json:"value"`
+	Seals []SealEntry  `json:"seals"`
+}
+
+// OutPoint returns the output of the UTXO
+func (u *UTXO) OutPoint() OutPoint {
+	return OutPoint{Txid: u.Txid, Vout: u.Vout}
+}
+
+//struct SimpleMultiMap<Key, Value> has store, copy, drop {
+//data: vector<Entry<Key, Value>>,
+//}
+//struct Entry<Key, Value> has store, copy, drop {
+//key: Key,
+//value: vector<Value>,
+//}
+
+// SealEntry is an entry of the seals SimpleMultiMap
+type SealEntry struct {
+	Key   string
+	Value []ObjectID
+}
+
+func (e *SealEntry) MarshalBCS(ser *bcs.Serializer) {
+	ser.WriteString(e.Key)
+	bcs.SerializeSequence(e.Value, ser)
+}
+func (e *SealEntry) UnmarshalBCS(des *bcs.Deserializer) {
+	e.Key = des.ReadString()
+	e.Value = bcs.DeserializeSequence[ObjectID](des)
+}
+
+func (e *SealEntry) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		Key   string     `json:"key"`
+		Value []ObjectID `json:"value"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return err
+	}
+	e.Key = data.Key
+	e.Value = data.Value
+	return nil
+}
+
+// SealsMap returns the seals by protocol type
+func (u *UTXO) SealsMap() map[string][]ObjectID {
+	seals := make(map[string][]ObjectID, len(u.Seals))
+	for _, entry := range u.Seals {
+		seals[entry.Key] = append(seals[entry.Key], entry.Value...)
+	}
+	return seals
+}
+
+func (u *UTXO) MarshalBCS(ser *bcs.Serializer) {
+	u.Txid.MarshalBCS(ser)
+	ser.U32(u.Vout)
+	ser.U64(u.Value)
+	bcs.SerializeSequence(u.Seals, ser)
+}
+func (u *UTXO) UnmarshalBCS(des *bcs.Deserializer) {
+	u.Txid.UnmarshalBCS(des)
+	u.Vout = des.U32()
+	u.Value = des.U64()
+	u.Seals = bcs.DeserializeSequence[SealEntry](des)
+}
+
+// UnmarshalJSON converts the UTXO from its annotated Move struct
+func (u *UTXO) UnmarshalJSON(b []byte) error {
+	type seals struct {
+		Data []SealEntry `json:"data"`
+	}
+	type inner struct {
+		Txid  RoochAddress    `json:"txid"`
+		Vout  uint32          `json:"vout"`
+		Value strU64          `json:"value"`
+		Seals json.RawMessage `json:"seals"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to UTXO: %w", err)
+	}
+	s := &seals{}
+	if len(data.Seals) > 0 {
+		if err := unmarshalAnnotatedStruct(data.Seals, s); err != nil {
+			return fmt.Errorf("failed to convert input to UTXO seals: %w", err)
+		}
+	}
+	u.Txid = data.Txid
+	u.Vout = data.Vout
+	u.Value = uint64(data.Value)
+	u.Seals = s.Data
+	return nil
+}
+
+//struct Inscription has key {
+//id: InscriptionID,
+//location: SatPoint,
+//sequence_number: u32,
+//inscription_number: u32,
+//is_cursed: bool,
+//charms: u16,
+//body: vector<u8>,
+//content_encoding: Option<String>,
+//content_type: Option<String>,
+//metadata: vector<u8>,
+//metaprotocol: Option<String>,
+//parents: vector<ObjectID>,
+//pointer: Option<u64>,
+//rune: Option<u128>,
+//}
+
+// Inscription is a 0x4::ord::Inscription object
+type Inscription struct {
+	ID                InscriptionID `json:"id"`
+	Location          SatPoint      `json:"location"`
+	SequenceNumber    uint32        `json:"sequence_number"`
+	InscriptionNumber uint32        `json:"inscription_number"`
+	IsCursed          bool          `json:"is_cursed"`
+	Charms            uint16        `json:"charms"`
+	Body              []byte        `json:"body"`
+	ContentEncoding   *string       `json:"content_encoding"`
+	ContentType       *string       `json:"content_type"`
+	Metadata          []byte        `json:"metadata"`
+	Metaprotocol      *string       `json:"metaprotocol"`
+	Parents           []ObjectID    `json:"parents"`
+	Pointer           *uint64       `json:"pointer"`
+	Rune              *big.Int      `json:"rune"`
+}
+
+func (i *Inscription) MarshalBCS(ser *bcs.Serializer) {
+	i.ID.MarshalBCS(ser)
+	i.Location.MarshalBCS(ser)
+	ser.U32(i.SequenceNumber)
+	ser.U32(i.InscriptionNumber)
+	ser.Bool(i.IsCursed)
+	ser.U16(i.Charms)
+	ser.WriteBytes(i.Body)
+	serializeOptionString(ser, i.ContentEncoding)
+	serializeOptionString(ser, i.ContentType)
+	ser.WriteBytes(i.Metadata)
+	serializeOptionString(ser, i.Metaprotocol)
+	bcs.SerializeSequence(i.Parents, ser)
+	if i.Pointer == nil {
+		ser.Bool(false)
+	} else {
+		ser.Bool(true)
+		ser.U64(*i.Pointer)
+	}
+	if i.Rune == nil {
+		ser.Bool(false)
+	} else {
+		ser.Bool(true)
+		ser.U128(*i.Rune)
+	}
+}
+func (i *Inscription) UnmarshalBCS(des *bcs.Deserializer) {
+	i.ID.UnmarshalBCS(des)
+	i.Location.UnmarshalBCS(des)
+	i.SequenceNumber = des.U32()
+	i.InscriptionNumber = des.U32()
+	i.IsCursed = des.Bool()
+	i.Charms = des.U16()
+	i.Body = des.ReadBytes()
+	i.ContentEncoding = deserializeOptionString(des)
+	i.ContentType = deserializeOptionString(des)
+	i.Metadata = des.ReadBytes()
+	i.Metaprotocol = deserializeOptionString(des)
+	i.Parents = bcs.DeserializeSequence[ObjectID](des)
+	i.Pointer = nil
+	if des.Bool() {
+		pointer := des.U64()
+		i.Pointer = &pointer
+	}
+	i.Rune = nil
+	if des.Bool() {
+		value := des.U128()
+		i.Rune = &value
+	}
+}
+
+// UnmarshalJSON converts the Inscription from its annotated Move struct
+func (i *Inscription) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		ID                InscriptionID         `json:"id"`
+		Location          SatPoint              `json:"location"`
+		SequenceNumber    uint32                `json:"sequence_number"`
+		InscriptionNumber uint32                `json:"inscription_number"`
+		IsCursed          bool                  `json:"is_cursed"`
+		Charms            uint16                `json:"charms"`
+		Body              hexBytes              `json:"body"`
+		ContentEncoding   moveOption[string]    `json:"content_encoding"`
+		ContentType       moveOption[string]    `json:"content_type"`
+		Metadata          hexBytes              `json:"metadata"`
+		Metaprotocol      moveOption[string]    `json:"metaprotocol"`
+		Parents           []ObjectID            `json:"parents"`
+		Pointer           moveOption[strU64]    `json:"pointer"`
+		Rune              moveOption[strBigInt] `json:"rune"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to Inscription: %w", err)
+	}
+	i.ID = data.ID
+	i.Location = data.Location
+	i.SequenceNumber = data.SequenceNumber
+	i.InscriptionNumber = data.InscriptionNumber
+	i.IsCursed = data.IsCursed
+	i.Charms = data.Charms
+	i.Body = data.Body
+	i.ContentEncoding = data.ContentEncoding.Value
+	i.ContentType = data.ContentType.Value
+	i.Metadata = data.Metadata
+	i.Metaprotocol = data.Metaprotocol.Value
+	i.Parents = data.Parents
+	i.Pointer = nil
+	if data.Pointer.Value != nil {
+		pointer := uint64(*data.Pointer.Value)
+		i.Pointer = &pointer
+	}
+	i.Rune = nil
+	if data.Rune.Value != nil {
+		i.Rune = data.Rune.Value.BigInt()
+	}
+	return nil
+}
@@ -13,7 +13,7 @@ type MultiChainIDVariant uint64
 
 const (
 	MultiChainIDVariantBitcoin MultiChainIDVariant = 0
-	MultiChainIDVariantEther   MultiChainIDVariant = 60 // Deprecated
+	MultiChainIDVariantEther   MultiChainIDVariant = 60
 	MultiChainIDVariantSui     MultiChainIDVariant = 784
 	MultiChainIDVariantNostr   MultiChainIDVariant = 1237
 	MultiChainIDVariantRooch   MultiChainIDVariant = 20230101
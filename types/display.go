@@ -0,0 +1,237 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+)
+
+var ErrInvalidDisplayTemplate = errors.New("invalid display template")
+var ErrDisplayFieldNotFound = errors.New("display field not found")
+
+// NewDisplayStructTag creates the 0x2::display::Display type of the display of objectType
+func NewDisplayStructTag(objectType *StructTag) *StructTag {
+	return &StructTag{Address: AddressTwo, Module: "display", Name: "Display", TypeParams: []TypeTag{*TypeTagFromStructTag(objectType)}}
+}
+
+// DisplayObjectID returns the ID of the display of objectType, a named object of its Display type
+func DisplayObjectID(objectType *StructTag) ObjectID {
+	return NamedObjectID(NewDisplayStructTag(objectType))
+}
+
+//struct Display<phantom T> has key {
+//sample_map: SimpleMap<String, String>
+//}
+
+// Display is a 0x2::display::Display object, the templates of the display fields of the objects of a type.
+// A template is text with {field.sub} placeholders, \{ and \} are literal braces. Fields are the elements
+// of the sample_map SimpleMap, in their order on chain
+type Display struct {
+	Fields []DisplayEntry `json:"fields"`
+}
+
+// DisplayEntry is an element of the sample_map SimpleMap, the template of a display field
+type DisplayEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (e *DisplayEntry) MarshalBCS(ser *bcs.Serializer) {
+	ser.WriteString(e.Key)
+	ser.WriteString(e.Value)
+}
+func (e *DisplayEntry) UnmarshalBCS(des *bcs.Deserializer) {
+	e.Key = des.ReadString()
+	e.Value = des.ReadString()
+}
+
+func (e *DisplayEntry) UnmarshalJSON(b []byte) error {
+	type inner DisplayEntry
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return err
+	}
+	*e = DisplayEntry(*data)
+	return nil
+}
+
+func (d *Display) MarshalBCS(ser *bcs.Serializer) {
+	bcs.SerializeSequence(d.Fields, ser)
+}
+func (d *Display) UnmarshalBCS(des *bcs.Deserializer) {
+	d.Fields = bcs.DeserializeSequence[DisplayEntry](des)
+}
+
+// UnmarshalJSON converts the Display from its annotated Move struct
+func (d *Display) UnmarshalJSON(b []byte) error {
+	type sampleMap struct {
+		Data []DisplayEntry `json:"data"`
+	}
+	type inner struct {
+		SampleMap json.RawMessage `json:"sample_map"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to Display: %w", err)
+	}
+	entries := &sampleMap{}
+	if len(data.SampleMap) > 0 {
+		if err := unmarshalAnnotatedStruct(data.SampleMap, entries); err != nil {
+			return fmt.Errorf("failed to convert input to Display: %w", err)
+		}
+	}
+	d.Fields = entries.Data
+	return nil
+}
+
+// FieldsMap returns the templates by display field
+func (d *Display) FieldsMap() map[string]string {
+	fields := make(map[string]string, len(d.Fields))
+	for _, entry := range d.Fields {
+		fields[entry.Key] = entry.Value
+	}
+	return fields
+}
+
+// Render renders every template of the display against the fields of an object, see [RenderDisplayTemplate]
+func (d *Display) Render(fields map[string]any) (map[string]string, error) {
+	rendered := make(map[string]string, len(d.Fields))
+	for _, entry := range d.Fields {
+		value, err := RenderDisplayTemplate(entry.Value, fields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render display field %s: %w", entry.Key, err)
+		}
+		rendered[entry.Key] = value
+	}
+	return rendered, nil
+}
+
+// RenderDisplayTemplate replaces the {field.sub} placeholders of the template with the values of the
+// fields, a path into nested maps. Strings are inserted as is, other values as JSON
+func RenderDisplayTemplate(template string, fields map[string]any) (string, error) {
+	var out strings.Builder
+	for i := 0; i < len(template); i++ {
+		switch c := template[i]; c {
+		case '\\':
+			if i+1 < len(template) && (template[i+1] == '{' || template[i+1] == '}') {
+				i++
+				out.WriteByte(template[i])
+			} else {
+				out.WriteByte(c)
+			}
+		case '{':
+			end := strings.IndexByte(template[i+1:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("%w: unclosed { at %d of %q", ErrInvalidDisplayTemplate, i, template)
+			}
+			path := strings.TrimSpace(template[i+1 : i+1+end])
+			if path == "" || strings.ContainsRune(path, '{') {
+				return "", fmt.Errorf("%w: invalid placeholder at %d of %q", ErrInvalidDisplayTemplate, i, template)
+			}
+			value, err := lookupDisplayField(fields, path)
+			if err != nil {
+				return "", err
+			}
+			out.WriteString(value)
+			i += end + 1
+		case '}':
+			return "", fmt.Errorf("%w: unopened } at %d of %q", ErrInvalidDisplayTemplate, i, template)
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return out.String(), nil
+}
+
+func lookupDisplayField(fields map[string]any, path string) (string, error) {
+	var value any = fields
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrDisplayFieldNotFound, path)
+		}
+		if value, ok = object[name]; !ok {
+			return "", fmt.Errorf("%w: %s", ErrDisplayFieldNotFound, path)
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	}
+}
+
+// DisplayContext returns the fields a display template of the object is rendered against, the metadata of
+// the object and its decoded value as value, e.g. {id}, {owner} or {value.name}
+func (ov *ObjectStateView) DisplayContext() (map[string]any, error) {
+	if len(ov.DecodedValue) == 0 || string(ov.DecodedValue) == "null" {
+		return nil, fmt.Errorf("object %s has no decoded value", ov.Metadata.ID.String())
+	}
+	decoder := json.NewDecoder(bytes.NewReader(ov.DecodedValue))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to decode value of object %s: %w", ov.Metadata.ID.String(), err)
+	}
+	stateRoot := ""
+	if ov.Metadata.StateRoot != nil {
+		stateRoot = ov.Metadata.StateRoot.String()
+	}
+	return map[string]any{
+		"id":         ov.Metadata.ID.String(),
+		"owner":      ov.Metadata.Owner.StringLong(),
+		"flag":       fmt.Sprintf("%d", ov.Metadata.Flag),
+		"state_root": stateRoot,
+		"size":       fmt.Sprintf("%d", ov.Metadata.Size),
+		"created_at": fmt.Sprintf("%d", ov.Metadata.CreatedAt),
+		"updated_at": fmt.Sprintf("%d", ov.Metadata.UpdatedAt),
+		"value":      unwrapAnnotatedValue(value),
+	}, nil
+}
+
+// unwrapAnnotatedValue replaces the annotated Move structs of a decoded value with their fields
+func unwrapAnnotatedValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if _, ok := v["abilities"]; ok {
+			if _, ok := v["type"]; ok {
+				if fields, ok := v["value"].(map[string]any); ok {
+					return unwrapAnnotatedValue(fields)
+				}
+			}
+		}
+		unwrapped := make(map[string]any, len(v))
+		for key, field := range v {
+			unwrapped[key] = unwrapAnnotatedValue(field)
+		}
+		return unwrapped
+	case []any:
+		unwrapped := make([]any, len(v))
+		for i, item := range v {
+			unwrapped[i] = unwrapAnnotatedValue(item)
+		}
+		return unwrapped
+	default:
+		return value
+	}
+}
+
+// RenderDisplay renders the display against the object, see [ObjectStateView.DisplayContext]
+func (ov *ObjectStateView) RenderDisplay(display *Display) (map[string]string, error) {
+	fields, err := ov.DisplayContext()
+	if err != nil {
+		return nil, err
+	}
+	return display.Render(fields)
+}
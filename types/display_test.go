@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderDisplayTemplate(t *testing.T) {
+	fields := map[string]any{
+		"id": "0x01",
+		"value": map[string]any{
+			"name":  "Rooch #1",
+			"level": json.Number("3"),
+			"attrs": map[string]any{"color": "red"},
+		},
+	}
+	tests := []struct {
+		name     string
+		template string
+		expected string
+		err      error
+	}{
+		{"plain", "Rooch", "Rooch", nil},
+		{"field", "{value.name}", "Rooch #1", nil},
+		{"nested", "https://rooch.network/{id}/{value.attrs.color}.png", "https://rooch.network/0x01/red.png", nil},
+		{"number", "level {value.level}", "level 3", nil},
+		{"object", "{value.attrs}", `{"color":"red"}`, nil},
+		{"escaped", `\{value.name\}`, "{value.name}", nil},
+		{"missing", "{value.missing}", "", ErrDisplayFieldNotFound},
+		{"not an object", "{id.value}", "", ErrDisplayFieldNotFound},
+		{"unclosed", "{value.name", "", ErrInvalidDisplayTemplate},
+		{"unopened", "value}", "", ErrInvalidDisplayTemplate},
+		{"empty", "{}", "", ErrInvalidDisplayTemplate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := RenderDisplayTemplate(tt.template, fields)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rendered)
+		})
+	}
+}
+
+func TestDisplay(t *testing.T) {
+	input := `{"abilities":8,"type":"0x2::display::Display<0x42::nft::NFT>","value":{"sample_map":{"abilities":7,
+		"type":"0x2::simple_map::SimpleMap<0x1::string::String, 0x1::string::String>","value":{"data":[
+		{"abilities":7,"type":"0x2::simple_map::Element<0x1::string::String, 0x1::string::String>","value":{"key":"name","value":"{value.name}"}},
+		{"abilities":7,"type":"0x2::simple_map::Element<0x1::string::String, 0x1::string::String>","value":{"key":"image_url","value":"https://nft.rooch.network/{id}.png"}}]}}}}`
+	display := &Display{}
+	assert.NoError(t, json.Unmarshal([]byte(input), display))
+	// The fields keep the order of the SimpleMap, which is not the key order
+	assert.Equal(t, []DisplayEntry{{Key: "name", Value: "{value.name}"}, {Key: "image_url", Value: "https://nft.rooch.network/{id}.png"}}, display.Fields)
+	assert.Equal(t, map[string]string{"name": "{value.name}", "image_url": "https://nft.rooch.network/{id}.png"}, display.FieldsMap())
+
+	encoded, err := bcs.Serialize(display)
+	assert.NoError(t, err)
+	decoded := &Display{}
+	assert.NoError(t, bcs.Deserialize(decoded, encoded))
+	assert.Equal(t, display, decoded)
+
+	nftType := &StructTag{Address: AddressTwo, Module: "nft", Name: "NFT"}
+	view := &ObjectStateView{
+		ObjectState: ObjectState{Metadata: ObjectMeta{
+			ID:         CustomObjectID([]byte{1}, nftType),
+			Owner:      AddressThree,
+			ObjectType: *TypeTagFromStructTag(nftType),
+		}},
+		DecodedValue: json.RawMessage(`{"abilities":12,"type":"0x2::nft::NFT","value":{"name":"Rooch #1"}}`),
+	}
+	rendered, err := view.RenderDisplay(display)
+	assert.NoError(t, err)
+	assert.Equal(t, "Rooch #1", rendered["name"])
+	assert.Equal(t, "https://nft.rooch.network/"+view.Metadata.ID.String()+".png", rendered["image_url"])
+
+	view.DecodedValue = nil
+	_, err = view.RenderDisplay(display)
+	assert.Error(t, err)
+
+	assert.Equal(t, "0x2::display::Display<0x2::nft::NFT>", NewDisplayStructTag(nftType).String())
+}
@@ -0,0 +1,400 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+)
+
+// AccountStructTag is the type of the 0x2::account::Account object of every account
+var AccountStructTag = StructTag{Address: AddressTwo, Module: "account", Name: "Account"}
+
+// SessionKeysStructTag is the type of the 0x3::session_key::SessionKeys object holding the session keys of an account
+var SessionKeysStructTag = StructTag{Address: AddressThree, Module: "session_key", Name: "SessionKeys"}
+
+// NewCoinInfoStructTag creates the 0x3::coin::CoinInfo type of a coin
+func NewCoinInfoStructTag(coinType *StructTag) *StructTag {
+	return &StructTag{Address: AddressThree, Module: "coin", Name: "CoinInfo", TypeParams: []TypeTag{*TypeTagFromStructTag(coinType)}}
+}
+
+// NewCoinStoreStructTag creates the 0x3::coin_store::CoinStore type of a coin
+func NewCoinStoreStructTag(coinType *StructTag) *StructTag {
+	return &StructTag{Address: AddressThree, Module: "coin_store", Name: "CoinStore", TypeParams: []TypeTag{*TypeTagFromStructTag(coinType)}}
+}
+
+//struct ObjectEntity<T> has key {
+//id: ObjectID,
+//owner: address,
+//flag: u8,
+//state_root: address,
+//size: u64,
+//created_at: u64,
+//updated_at: u64,
+//value: T,
+//}
+
+// ObjectEntity is a 0x2::object::ObjectEntity, an object with the value of type T. The BCS of T
+// is decoded through its [bcs.Unmarshaler] when *T implements it
+type ObjectEntity[T any] struct {
+	ID        ObjectID     `json:"id"`
+	Owner     RoochAddress `json:"owner"`
+	Flag      uint8        `json:"flag"`
+	StateRoot RoochAddress `json:"state_root"`
+	Size      uint64       `json:"size"`
+	CreatedAt uint64       `json:"created_at"`
+	UpdatedAt uint64       `json:"updated_at"`
+	Value     T            `json:"value"`
+}
+
+// NewObjectEntity decodes the BCS value of the object state into an [ObjectEntity]
+func NewObjectEntity[T any](state *ObjectState) (*ObjectEntity[T], error) {
+	entity := &ObjectEntity[T]{
+		ID:        state.Metadata.ID,
+		Owner:     state.Metadata.Owner,
+		Flag:      state.Metadata.Flag,
+		Size:      state.Metadata.Size,
+		CreatedAt: state.Metadata.CreatedAt,
+		UpdatedAt: state.Metadata.UpdatedAt,
+	}
+	if state.Metadata.StateRoot != nil {
+		copy(entity.StateRoot[:], state.Metadata.StateRoot[:])
+	}
+	value, ok := any(&entity.Value).(bcs.Unmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("value of object %s is not bcs.Unmarshaler", state.Metadata.ID.String())
+	}
+	if err := bcs.Deserialize(value, state.Value); err != nil {
+		return nil, fmt.Errorf("failed to decode value of object %s: %w", state.Metadata.ID.String(), err)
+	}
+	return entity, nil
+}
+
+func (oe *ObjectEntity[T]) MarshalBCS(ser *bcs.Serializer) {
+	oe.ID.MarshalBCS(ser)
+	oe.Owner.MarshalBCS(ser)
+	ser.U8(oe.Flag)
+	oe.StateRoot.MarshalBCS(ser)
+	ser.U64(oe.Size)
+	ser.U64(oe.CreatedAt)
+	ser.U64(oe.UpdatedAt)
+	value, ok := any(&oe.Value).(bcs.Marshaler)
+	if !ok {
+		ser.SetError(fmt.Errorf("value of object %s is not bcs.Marshaler", oe.ID.String()))
+		return
+	}
+	value.MarshalBCS(ser)
+}
+func (oe *ObjectEntity[T]) UnmarshalBCS(des *bcs.Deserializer) {
+	oe.ID.UnmarshalBCS(des)
+	oe.Owner.UnmarshalBCS(des)
+	oe.Flag = des.U8()
+	oe.StateRoot.UnmarshalBCS(des)
+	oe.Size = des.U64()
+	oe.CreatedAt = des.U64()
+	oe.UpdatedAt = des.U64()
+	value, ok := any(&oe.Value).(bcs.Unmarshaler)
+	if !ok {
+		des.SetError(fmt.Errorf("value of object %s is not bcs.Unmarshaler", oe.ID.String()))
+		return
+	}
+	value.UnmarshalBCS(des)
+}
+
+// UnmarshalJSON converts the ObjectEntity from its annotated Move struct
+func (oe *ObjectEntity[T]) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		ID        ObjectID        `json:"id"`
+		Owner     RoochAddress    `json:"owner"`
+		Flag      uint8           `json:"flag"`
+		StateRoot RoochAddress    `json:"state_root"`
+		Size      strU64          `json:"size"`
+		CreatedAt strU64          `json:"created_at"`
+		UpdatedAt strU64          `json:"updated_at"`
+		Value     json.RawMessage `json:"value"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to ObjectEntity: %w", err)
+	}
+	oe.ID = data.ID
+	oe.Owner = data.Owner
+	oe.Flag = data.Flag
+	oe.StateRoot = data.StateRoot
+	oe.Size = uint64(data.Size)
+	oe.CreatedAt = uint64(data.CreatedAt)
+	oe.UpdatedAt = uint64(data.UpdatedAt)
+	return json.Unmarshal(data.Value, &oe.Value)
+}
+
+//struct Account has key {
+//sequence_number: u64,
+//}
+
+// Account is the 0x2::account::Account object of an account
+type Account struct {
+	SequenceNumber uint64 `json:"sequence_number"`
+}
+
+func (a *Account) MarshalBCS(ser *bcs.Serializer) {
+	ser.U64(a.SequenceNumber)
+}
+func (a *Account) UnmarshalBCS(des *bcs.Deserializer) {
+	a.SequenceNumber = des.U64()
+}
+
+// UnmarshalJSON converts the Account from its annotated Move struct
+func (a *Account) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		SequenceNumber strU64 `json:"sequence_number"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to Account: %w", err)
+	}
+	a.SequenceNumber = uint64(data.SequenceNumber)
+	return nil
+}
+
+//struct CoinInfo<phantom CoinType: key> has key, store {
+//coin_type: string::String,
+//name: string::String,
+//symbol: string::String,
+//icon_url: Option<string::String>,
+//decimals: u8,
+//supply: u256,
+//}
+
+// CoinInfo is the 0x3::coin::CoinInfo object of a coin type
+type CoinInfo struct {
+	CoinType string   `json:"coin_type"`
+	Name     string   `json:"name"`
+	Symbol   string   `json:"symbol"`
+	IconURL  *string  `json:"icon_url"`
+	Decimals uint8    `json:"decimals"`
+	Supply   *big.Int `json:"supply"`
+}
+
+func (ci *CoinInfo) MarshalBCS(ser *bcs.Serializer) {
+	ser.WriteString(ci.CoinType)
+	ser.WriteString(ci.Name)
+	ser.WriteString(ci.Symbol)
+	serializeOptionString(ser, ci.IconURL)
+	ser.U8(ci.Decimals)
+	serializeBigInt(ser, ci.Supply, ser.U256)
+}
+func (ci *CoinInfo) UnmarshalBCS(des *bcs.Deserializer) {
+	ci.CoinType = des.ReadString()
+	ci.Name = des.ReadString()
+	ci.Symbol = des.ReadString()
+	ci.IconURL = deserializeOptionString(des)
+	ci.Decimals = des.U8()
+	supply := des.U256()
+	ci.Supply = &supply
+}
+
+// UnmarshalJSON converts the CoinInfo from its annotated Move struct
+func (ci *CoinInfo) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		CoinType string             `json:"coin_type"`
+		Name     string             `json:"name"`
+		Symbol   string             `json:"symbol"`
+		IconURL  moveOption[string] `json:"icon_url"`
+		Decimals uint8              `json:"decimals"`
+		Supply   strBigInt          `json:"supply"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to CoinInfo: %w", err)
+	}
+	ci.CoinType = data.CoinType
+	ci.Name = data.Name
+	ci.Symbol = data.Symbol
+	ci.IconURL = data.IconURL.Value
+	ci.Decimals = data.Decimals
+	ci.Supply = data.Supply.BigInt()
+	return nil
+}
+
+//struct CoinStore<phantom CoinType: key> has key {
+//balance: Balance,
+//frozen: bool,
+//}
+//struct Balance has store {
+//value: u256,
+//}
+
+// CoinStore is a 0x3::coin_store::CoinStore object holding the balance of a coin type
+type CoinStore struct {
+	Balance *big.Int `json:"balance"`
+	Frozen  bool     `json:"frozen"`
+}
+
+func (cs *CoinStore) MarshalBCS(ser *bcs.Serializer) {
+	serializeBigInt(ser, cs.Balance, ser.U256)
+	ser.Bool(cs.Frozen)
+}
+func (cs *CoinStore) UnmarshalBCS(des *bcs.Deserializer) {
+	balance := des.U256()
+	cs.Balance = &balance
+	cs.Frozen = des.Bool()
+}
+
+// UnmarshalJSON converts the CoinStore from its annotated Move struct, the balance is the value of the nested Balance
+func (cs *CoinStore) UnmarshalJSON(b []byte) error {
+	type balance struct {
+		Value strBigInt `json:"value"`
+	}
+	type inner struct {
+		Balance json.RawMessage `json:"balance"`
+		Frozen  bool            `json:"frozen"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to CoinStore: %w", err)
+	}
+	bal := &balance{}
+	if err := unmarshalAnnotatedStruct(data.Balance, bal); err != nil {
+		return fmt.Errorf("failed to convert input to CoinStore balance: %w", err)
+	}
+	cs.Balance = bal.Value.BigInt()
+	cs.Frozen = data.Frozen
+	return nil
+}
+
+//struct SessionScope has store, copy, drop {
+//module_address: address,
+//module_name: std::string::String,
+//function_name: std::string::String,
+//}
+
+// SessionScope is a function, or a wildcard "*" of module and function names, a session key may call
+type SessionScope struct {
+	ModuleAddress RoochAddress `json:"module_address"`
+	ModuleName    string       `json:"module_name"`
+	FunctionName  string       `json:"function_name"`
+}
+
+func (ss *SessionScope) MarshalBCS(ser *bcs.Serializer) {
+	ss.ModuleAddress.MarshalBCS(ser)
+	ser.WriteString(ss.ModuleName)
+	ser.WriteString(ss.FunctionName)
+}
+func (ss *SessionScope) UnmarshalBCS(des *bcs.Deserializer) {
+	ss.ModuleAddress.UnmarshalBCS(des)
+	ss.ModuleName = des.ReadString()
+	ss.FunctionName = des.ReadString()
+}
+
+// UnmarshalJSON converts the SessionScope from its annotated Move struct
+func (ss *SessionScope) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		ModuleAddress RoochAddress `json:"module_address"`
+		ModuleName    string       `json:"module_name"`
+		FunctionName  string       `json:"function_name"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to SessionScope: %w", err)
+	}
+	ss.ModuleAddress = data.ModuleAddress
+	ss.ModuleName = data.ModuleName
+	ss.FunctionName = data.FunctionName
+	return nil
+}
+
+//struct SessionKey has store, copy, drop {
+//app_name: std::string::String,
+//app_url: std::string::String,
+//authentication_key: vector<u8>,
+//scopes: vector<SessionScope>,
+//create_time: u64,
+//last_active_time: u64,
+//max_inactive_interval: u64,
+//}
+
+// SessionKey is a 0x3::session_key::SessionKey of an account, the times are in seconds
+type SessionKey struct {
+	AppName             string         `json:"app_name"`
+	AppURL              string         `json:"app_url"`
+	AuthenticationKey   []byte         `json:"authentication_key"`
+	Scopes              []SessionScope `json:"scopes"`
+	CreateTime          uint64         `json:"create_time"`
+	LastActiveTime      uint64         `json:"last_active_time"`
+	MaxInactiveInterval uint64         `json:"max_inactive_interval"`
+}
+
+// IsExpired tells if the session key has been inactive for longer than its max inactive interval at now, in seconds
+func (sk *SessionKey) IsExpired(now uint64) bool {
+	return sk.MaxInactiveInterval > 0 && now > sk.LastActiveTime+sk.MaxInactiveInterval
+}
+
+func (sk *SessionKey) MarshalBCS(ser *bcs.Serializer) {
+	ser.WriteString(sk.AppName)
+	ser.WriteString(sk.AppURL)
+	ser.WriteBytes(sk.AuthenticationKey)
+	bcs.SerializeSequence(sk.Scopes, ser)
+	ser.U64(sk.CreateTime)
+	ser.U64(sk.LastActiveTime)
+	ser.U64(sk.MaxInactiveInterval)
+}
+func (sk *SessionKey) UnmarshalBCS(des *bcs.Deserializer) {
+	sk.AppName = des.ReadString()
+	sk.AppURL = des.ReadString()
+	sk.AuthenticationKey = des.ReadBytes()
+	sk.Scopes = bcs.DeserializeSequence[SessionScope](des)
+	sk.CreateTime = des.U64()
+	sk.LastActiveTime = des.U64()
+	sk.MaxInactiveInterval = des.U64()
+}
+
+// UnmarshalJSON converts the SessionKey from its annotated Move struct
+func (sk *SessionKey) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		AppName             string         `json:"app_name"`
+		AppURL              string         `json:"app_url"`
+		AuthenticationKey   hexBytes       `json:"authentication_key"`
+		Scopes              []SessionScope `json:"scopes"`
+		CreateTime          strU64         `json:"create_time"`
+		LastActiveTime      strU64         `json:"last_active_time"`
+		MaxInactiveInterval strU64         `json:"max_inactive_interval"`
+	}
+	data := &inner{}
+	if err := unmarshalAnnotatedStruct(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to SessionKey: %w", err)
+	}
+	sk.AppName = data.AppName
+	sk.AppURL = data.AppURL
+	sk.AuthenticationKey = data.AuthenticationKey
+	sk.Scopes = data.Scopes
+	sk.CreateTime = uint64(data.CreateTime)
+	sk.LastActiveTime = uint64(data.LastActiveTime)
+	sk.MaxInactiveInterval = uint64(data.MaxInactiveInterval)
+	return nil
+}
+
+func serializeOptionString(ser *bcs.Serializer, value *string) {
+	if value == nil {
+		ser.Bool(false)
+		return
+	}
+	ser.Bool(true)
+	ser.WriteString(*value)
+}
+
+func deserializeOptionString(des *bcs.Deserializer) *string {
+	if !des.Bool() {
+		return nil
+	}
+	value := des.ReadString()
+	return &value
+}
+
+// serializeBigInt writes a u128 or u256, nil is zero
+func serializeBigInt(ser *bcs.Serializer, value *big.Int, write func(big.Int)) {
+	if value == nil {
+		value = new(big.Int)
+	}
+	write(*value)
+}
@@ -0,0 +1,207 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameworkObjectsJSON(t *testing.T) {
+	t.Run("coin store", func(t *testing.T) {
+		input := `{"abilities":8,"type":"0x3::coin_store::CoinStore<0x3::gas_coin::RGas>","value":{
+			"balance":{"abilities":4,"type":"0x3::coin_store::Balance","value":{"value":"123456789012345678901234567890"}},
+			"frozen":false}}`
+		store := &CoinStore{}
+		assert.NoError(t, json.Unmarshal([]byte(input), store))
+		expected, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+		assert.Equal(t, expected, store.Balance)
+		assert.False(t, store.Frozen)
+	})
+	t.Run("coin info", func(t *testing.T) {
+		for _, iconURL := range []string{`null`, `"https://rooch.network/gas.svg"`, `{"vec":["https://rooch.network/gas.svg"]}`} {
+			input := `{"coin_type":"0x3::gas_coin::RGas","name":"Rooch Gas Coin","symbol":"RGAS","icon_url":` + iconURL + `,"decimals":8,"supply":"1000"}`
+			info := &CoinInfo{}
+			assert.NoError(t, json.Unmarshal([]byte(input), info))
+			assert.Equal(t, "RGAS", info.Symbol)
+			assert.Equal(t, uint8(8), info.Decimals)
+			assert.Equal(t, big.NewInt(1000), info.Supply)
+			if iconURL == `null` {
+				assert.Nil(t, info.IconURL)
+			} else {
+				assert.Equal(t, "https://rooch.network/gas.svg", *info.IconURL)
+			}
+		}
+	})
+	t.Run("utxo", func(t *testing.T) {
+		input := `{"abilities":8,"type":"0x4::utxo::UTXO","value":{
+			"txid":"0x77dfc2fe598419b00641c296181a96cf16943697f573480b023b77cce82ada21","vout":1,"value":"10000",
+			"seals":{"abilities":7,"type":"0x2::simple_multimap::SimpleMultiMap<0x1::string::String, 0x2::object::ObjectID>","value":{"data":[
+				{"abilities":7,"type":"0x2::simple_multimap::Entry<0x1::string::String, 0x2::object::ObjectID>","value":{
+					"key":"0x4::ord::Inscription","value":["0x0000000000000000000000000000000000000000000000000000000000000004"]}}]}}}}`
+		utxo := &UTXO{}
+		assert.NoError(t, json.Unmarshal([]byte(input), utxo))
+		assert.Equal(t, "0x77dfc2fe598419b00641c296181a96cf16943697f573480b023b77cce82ada21", utxo.Txid.String())
+		assert.Equal(t, uint32(1), utxo.Vout)
+		assert.Equal(t, uint64(10000), utxo.Value)
+		assert.Len(t, utxo.Seals, 1)
+		seals := utxo.SealsMap()
+		assert.Len(t, seals["0x4::ord::Inscription"], 1)
+		assert.Equal(t, AddressFour, seals["0x4::ord::Inscription"][0].Address[0])
+	})
+	t.Run("inscription", func(t *testing.T) {
+		input := `{"id":{"txid":"0x77dfc2fe598419b00641c296181a96cf16943697f573480b023b77cce82ada21","index":0},
+			"location":{"outpoint":{"txid":"0x77dfc2fe598419b00641c296181a96cf16943697f573480b023b77cce82ada21","vout":0},"offset":"0"},
+			"sequence_number":12,"inscription_number":10,"is_cursed":false,"charms":0,"body":"0x68656c6c6f",
+			"content_encoding":null,"content_type":"text/plain","metadata":"0x","metaprotocol":null,
+			"parents":[],"pointer":"5","rune":null}`
+		inscription := &Inscription{}
+		assert.NoError(t, json.Unmarshal([]byte(input), inscription))
+		assert.Equal(t, []byte("hello"), inscription.Body)
+		assert.Equal(t, "text/plain", *inscription.ContentType)
+		assert.Nil(t, inscription.ContentEncoding)
+		assert.Equal(t, uint64(5), *inscription.Pointer)
+		assert.Nil(t, inscription.Rune)
+		assert.Equal(t, uint32(10), inscription.InscriptionNumber)
+	})
+	t.Run("session key", func(t *testing.T) {
+		input := `{"app_name":"test","app_url":"https://test.rooch.network","authentication_key":"0x0102",
+			"scopes":[{"module_address":"0x3","module_name":"*","function_name":"*"}],
+			"create_time":"100","last_active_time":"200","max_inactive_interval":"3600"}`
+		key := &SessionKey{}
+		assert.NoError(t, json.Unmarshal([]byte(input), key))
+		assert.Equal(t, []byte{1, 2}, key.AuthenticationKey)
+		assert.Equal(t, AddressThree, key.Scopes[0].ModuleAddress)
+		assert.False(t, key.IsExpired(3800))
+		assert.True(t, key.IsExpired(3801))
+	})
+}
+
+func TestFrameworkObjectsBCS(t *testing.T) {
+	contentType := "text/plain"
+	pointer := uint64(1)
+	type value interface {
+		bcs.Marshaler
+		bcs.Unmarshaler
+	}
+	tests := []struct {
+		name    string
+		value   value
+		decoded value
+	}{
+		{"account", &Account{SequenceNumber: 7}, &Account{}},
+		{"coin info", &CoinInfo{CoinType: "0x3::gas_coin::RGas", Name: "Rooch Gas Coin", Symbol: "RGAS", Decimals: 8, Supply: big.NewInt(1000)}, &CoinInfo{}},
+		{"coin store", &CoinStore{Balance: big.NewInt(42), Frozen: true}, &CoinStore{}},
+		{"session key", &SessionKey{
+			AppName: "test", AuthenticationKey: []byte{1, 2},
+			Scopes:     []SessionScope{{ModuleAddress: AddressThree, ModuleName: "*", FunctionName: "*"}},
+			CreateTime: 1, LastActiveTime: 2, MaxInactiveInterval: 3,
+		}, &SessionKey{}},
+		// The seals are not in key order, their order on chain is kept
+		{"utxo", &UTXO{Txid: AddressFour, Vout: 2, Value: 546, Seals: []SealEntry{
+			{Key: "0x4::ord::Inscription", Value: []ObjectID{NewObjectID([]RoochAddress{AddressTwo})}},
+			{Key: "0x4::bitseed::Bitseed", Value: []ObjectID{NewObjectID([]RoochAddress{AddressThree})}},
+		}}, &UTXO{}},
+		{"inscription", &Inscription{
+			ID: InscriptionID{Txid: AddressFour}, Location: SatPoint{OutPoint: OutPoint{Txid: AddressFour}, Offset: 3},
+			Body: []byte("hello"), ContentType: &contentType, Metadata: []byte{}, Parents: []ObjectID{},
+			Pointer: &pointer, Rune: big.NewInt(9),
+		}, &Inscription{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := bcs.Serialize(tt.value)
+			assert.NoError(t, err)
+			assert.NoError(t, bcs.Deserialize(tt.decoded, encoded))
+			assert.Equal(t, tt.value, tt.decoded)
+		})
+	}
+}
+
+func TestNewObjectEntity(t *testing.T) {
+	value, err := bcs.Serialize(&Account{SequenceNumber: 3})
+	assert.NoError(t, err)
+	state := &ObjectState{
+		Metadata: ObjectMeta{
+			ID:         AccountNamedObjectID(AddressThree, &AccountStructTag),
+			Owner:      AddressThree,
+			CreatedAt:  10,
+			UpdatedAt:  11,
+			ObjectType: *TypeTagFromStructTag(&AccountStructTag),
+		},
+		Value: value,
+	}
+	entity, err := NewObjectEntity[Account](state)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), entity.Value.SequenceNumber)
+	assert.Equal(t, uint64(11), entity.UpdatedAt)
+	assert.True(t, entity.ID.Equals(&state.Metadata.ID))
+
+	encoded, err := bcs.Serialize(entity)
+	assert.NoError(t, err)
+	decoded := &ObjectEntity[Account]{}
+	assert.NoError(t, bcs.Deserialize(decoded, encoded))
+	assert.Equal(t, entity, decoded)
+
+	_, err = NewObjectEntity[string](state)
+	assert.Error(t, err)
+}
+
+func TestInscriptionID(t *testing.T) {
+	input := "6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799i7"
+	id, err := ParseInscriptionID(input)
+	assert.NoError(t, err)
+	// The txid is stored in the internal byte order
+	assert.Equal(t, byte(0x99), id.Txid[0])
+	assert.Equal(t, byte(0x6f), id.Txid[31])
+	assert.Equal(t, uint32(7), id.Index)
+	assert.Equal(t, input, id.String())
+
+	assert.Equal(t, "0x9927442e695da8d0cef7abc2a7e14a20835939841e201e7f01ecdc49ab76b96f", id.Txid.String())
+
+	// sha3_256(bcs(id) || "0x00..04::ord::Inscription"), computed outside of the SDK
+	objectID, err := id.ObjectID()
+	assert.NoError(t, err)
+	assert.Equal(t, "0x7b1073e2e4f27047ad380dc5e4c4bc532b0375d0d88b0490e7bab77aa6b9028d", objectID.String())
+	id.Index = 0
+	objectID, err = id.ObjectID()
+	assert.NoError(t, err)
+	assert.Equal(t, "0x085d7fa27c08c31c4d9dfdb7e6a1f3020850b8d28a1d11f50164f080b77b5f34", objectID.String())
+
+	for _, invalid := range []string{"", "6fb976abi0", input[:64], input[:64] + "i-1", "zz" + input[2:]} {
+		_, err := ParseInscriptionID(invalid)
+		assert.ErrorIs(t, err, ErrInvalidInscriptionID, invalid)
+	}
+}
+
+func TestOutPoint(t *testing.T) {
+	input := "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b:1"
+	outpoint, err := ParseOutPoint(input)
+	assert.NoError(t, err)
+	// The txid of the input is in the display byte order, the reverse of the stored txid
+	assert.Equal(t, "0x3ba3edfd7a7b12b27ac72c3e67768f617fc81bc3888a51323a9fb8aa4b1e5e4a", outpoint.Txid.String())
+	assert.Equal(t, uint32(1), outpoint.Vout)
+	assert.Equal(t, input, outpoint.String())
+
+	// sha3_256(bcs(outpoint) || "0x00..04::utxo::UTXO"), computed outside of the SDK
+	objectID, err := outpoint.ObjectID()
+	assert.NoError(t, err)
+	assert.Equal(t, "0xcc4ca9e359ef9dae42965b83f44a4a0bdb4d81e3ef01efe0aa405f407663e732", objectID.String())
+	first := OutPoint{Txid: outpoint.Txid}
+	firstID, err := first.ObjectID()
+	assert.NoError(t, err)
+	assert.Equal(t, "0xdf947fb47fa9aeb8448d282ad30172b5c82622bc5b17a5e80ae10a99e902de81", firstID.String())
+
+	utxo := &UTXO{Txid: outpoint.Txid, Vout: outpoint.Vout}
+	utxoOutPoint := utxo.OutPoint()
+	fromUTXO, err := utxoOutPoint.ObjectID()
+	assert.NoError(t, err)
+	assert.True(t, fromUTXO.Equals(&objectID))
+
+	for _, invalid := range []string{"", input[:64], input[:64] + ":x", input[2:], input[:64] + ":4294967296"} {
+		_, err := ParseOutPoint(invalid)
+		assert.ErrorIs(t, err, ErrInvalidOutPoint, invalid)
+	}
+}
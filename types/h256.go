@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+)
+
+// H256Length is the length of a [H256] in bytes
+const H256Length = 32
+
+// H256 is a 32 bytes hash such as a transaction hash or a state root, it is hex encoded in JSON and
+// encoded as a 32 bytes vector in BCS, like the Rust primitive_types::H256
+type H256 [H256Length]byte
+
+// NewH256 creates a H256 from 32 bytes
+func NewH256(bytes []byte) (H256, error) {
+	var h H256
+	if len(bytes) != H256Length {
+		return h, fmt.Errorf("invalid H256 length %d", len(bytes))
+	}
+	copy(h[:], bytes)
+	return h, nil
+}
+
+// ParseH256 parses the hex string of a H256, with or without 0x
+func ParseH256(input string) (H256, error) {
+	bytes, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return H256{}, fmt.Errorf("invalid H256 %s: %w", input, err)
+	}
+	return NewH256(bytes)
+}
+
+// Bytes returns the hash as a byte slice
+func (h H256) Bytes() []byte {
+	return h[:]
+}
+
+// String returns the 0x prefixed hex of the hash
+func (h H256) String() string {
+	return "0x" + hex.EncodeToString(h[:])
+}
+
+// MarshalJSON converts the H256 to its hex string
+func (h H256) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+// UnmarshalJSON converts the H256 from its hex string
+func (h *H256) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("failed to convert input to H256: %w", err)
+	}
+	parsed, err := ParseH256(str)
+	if err != nil {
+		return err
+	}
+	*h = parsed
+	return nil
+}
+
+func (h *H256) MarshalBCS(ser *bcs.Serializer) {
+	ser.WriteBytes(h[:])
+}
+
+func (h *H256) UnmarshalBCS(des *bcs.Deserializer) {
+	bytes := des.ReadBytes()
+	if des.Error() != nil {
+		return
+	}
+	parsed, err := NewH256(bytes)
+	if err != nil {
+		des.SetError(err)
+		return
+	}
+	*h = parsed
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
 	"github.com/rooch-network/rooch-go-sdk/bcs"
 )
 
@@ -22,6 +26,25 @@ func (mod *ModuleId) UnmarshalBCS(des *bcs.Deserializer) {
 	mod.Name = des.ReadString()
 }
 
+// String outputs to the form address::module e.g. 0x3::transfer
+func (mod *ModuleId) String() string {
+	return fmt.Sprintf("%s::%s", mod.Address.String(), mod.Name)
+}
+
+// ParseModuleId parses a module id of the form address::module, the address can be in any form
+// accepted by [address.ParseRoochAddress]
+func ParseModuleId(input string) (*ModuleId, error) {
+	parts := strings.Split(input, "::")
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid module id %s", input)
+	}
+	moduleAddress, err := address.ParseRoochAddress(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid module id %s: %w", input, err)
+	}
+	return &ModuleId{Address: *moduleAddress, Name: parts[1]}, nil
+}
+
 type FunctionId struct {
 	ModuleId     ModuleId   `json:"module_id"`
 	FunctionName Identifier `json:"function_name"`
@@ -2,17 +2,25 @@ package types
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"github.com/rooch-network/rooch-go-sdk/address"
 	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+	"strings"
 )
 
 //const ROOCH_ADDRESS_LENGTH = 32
 
+// ObjectID is the path of addresses from the root object, a child object ID is its
+// parent ID followed by the field key of the child
 type ObjectID struct {
 	Address []RoochAddress
 }
 
+// FieldKey identifies a field, or a child object, in its parent object
+type FieldKey = RoochAddress
+
 func NewObjectID(address []RoochAddress) ObjectID {
 	return ObjectID{
 		Address: address,
@@ -77,3 +85,113 @@ func (o *ObjectID) MarshalBCS(ser *bcs.Serializer) {
 func (o *ObjectID) UnmarshalBCS(des *bcs.Deserializer) {
 	o.Address = bcs.DeserializeSequence[RoochAddress](des)
 }
+
+// ParseObjectID parses the string form of [ObjectID.String], a hex string of the
+// concatenated path, "0x" being the root object
+func ParseObjectID(input string) (ObjectID, error) {
+	hexStr := strings.TrimPrefix(input, "0x")
+	// A single short address such as 0x3 is left padded like addresses are
+	if len(hexStr) > 0 && len(hexStr) < 2*address.RoochAddressLength {
+		hexStr = strings.Repeat("0", 2*address.RoochAddressLength-len(hexStr)) + hexStr
+	}
+	bytes, err := fromHEX(hexStr)
+	if err != nil {
+		return ObjectID{}, fmt.Errorf("failed to parse object id: %w", err)
+	}
+	if len(bytes)%address.RoochAddressLength != 0 {
+		return ObjectID{}, fmt.Errorf("invalid object id length %d", len(bytes))
+	}
+	addresses := make([]RoochAddress, 0, len(bytes)/address.RoochAddressLength)
+	for offset := 0; offset < len(bytes); offset += address.RoochAddressLength {
+		addr, err := address.NewRoochAddressFromBytes(bytes[offset : offset+address.RoochAddressLength])
+		if err != nil {
+			return ObjectID{}, err
+		}
+		addresses = append(addresses, *addr)
+	}
+	return NewObjectID(addresses), nil
+}
+
+// MarshalJSON converts the ObjectID to its string form
+func (o *ObjectID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.String())
+}
+
+// UnmarshalJSON converts the ObjectID from its string form
+func (o *ObjectID) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("failed to convert input to ObjectID: %w", err)
+	}
+	parsed, err := ParseObjectID(str)
+	if err != nil {
+		return err
+	}
+	*o = parsed
+	return nil
+}
+
+// IsRoot returns true for the root object, whose path is empty
+func (o *ObjectID) IsRoot() bool {
+	return len(o.Address) == 0
+}
+
+// Parent returns the ID of the parent object, top level objects are children of the
+// root, the root itself has no parent
+func (o *ObjectID) Parent() (ObjectID, bool) {
+	if o.IsRoot() {
+		return ObjectID{}, false
+	}
+	return NewObjectID(append([]RoochAddress{}, o.Address[:len(o.Address)-1]...)), true
+}
+
+// ChildID returns the ID of the child object stored under key
+func (o *ObjectID) ChildID(key FieldKey) ObjectID {
+	path := make([]RoochAddress, 0, len(o.Address)+1)
+	path = append(path, o.Address...)
+	return NewObjectID(append(path, key))
+}
+
+// FieldObjectID returns the ID of the child object stored under the BCS encoded key of type keyType
+func (o *ObjectID) FieldObjectID(keyBcs []byte, keyType *TypeTag) ObjectID {
+	return o.ChildID(DeriveFieldKey(keyBcs, keyType))
+}
+
+// Equals checks if two object IDs have the same path
+func (o *ObjectID) Equals(other *ObjectID) bool {
+	return o.String() == other.String()
+}
+
+// DeriveFieldKey hashes a BCS encoded key with the canonical string of its type into a [FieldKey]
+func DeriveFieldKey(keyBcs []byte, keyType *TypeTag) FieldKey {
+	return hashToAddress(keyBcs, []byte(TypeTagToCanonicalString(keyType)))
+}
+
+// NamedObjectID returns the ID of the singleton object of the given type, same as [StructTagToObjectID]
+func NamedObjectID(st *StructTag) ObjectID {
+	return NewObjectID([]RoochAddress{hashToAddress([]byte(st.ToCanonicalString()))})
+}
+
+// AccountNamedObjectID returns the ID of the per account singleton object of the given type
+func AccountNamedObjectID(account RoochAddress, st *StructTag) ObjectID {
+	return NewObjectID([]RoochAddress{hashToAddress(account.Bytes(), []byte(st.ToCanonicalString()))})
+}
+
+// CustomObjectID returns the ID of an object created from the BCS encoded custom id and the object type
+func CustomObjectID(idBcs []byte, st *StructTag) ObjectID {
+	return NewObjectID([]RoochAddress{hashToAddress(idBcs, []byte(st.ToCanonicalString()))})
+}
+
+// CustomChildObjectID returns the ID of a custom object created as a child of parent
+func CustomChildObjectID(parent ObjectID, idBcs []byte, st *StructTag) ObjectID {
+	return parent.ChildID(CustomObjectID(idBcs, st).Address[0])
+}
+
+func hashToAddress(parts ...[]byte) RoochAddress {
+	var data []byte
+	for _, part := range parts {
+		data = append(data, part...)
+	}
+	addr, _ := address.NewRoochAddressFromBytes(utils.Sha3256(data))
+	return *addr
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+//pub struct ObjectMeta {
+//pub id: ObjectID,
+//pub owner: AccountAddress,
+//pub flag: u8,
+///// The state root of the fields
+//pub state_root: Option<H256>,
+//pub size: u64,
+//pub created_at: u64,
+//pub updated_at: u64,
+//pub object_type: TypeTag,
+//}
+
+// ObjectMeta is the metadata of an object, StateRoot is the root of the sparse Merkle tree of its fields
+type ObjectMeta struct {
+	ID         ObjectID     `json:"id"`
+	Owner      RoochAddress `json:"owner"`
+	Flag       uint8        `json:"flag"`
+	StateRoot  *H256        `json:"state_root"`
+	Size       uint64       `json:"size"`
+	CreatedAt  uint64       `json:"created_at"`
+	UpdatedAt  uint64       `json:"updated_at"`
+	ObjectType TypeTag      `json:"object_type"`
+}
+
+func (om *ObjectMeta) MarshalBCS(ser *bcs.Serializer) {
+	om.ID.MarshalBCS(ser)
+	om.Owner.MarshalBCS(ser)
+	ser.U8(om.Flag)
+	if om.StateRoot == nil {
+		ser.Bool(false)
+	} else {
+		ser.Bool(true)
+		om.StateRoot.MarshalBCS(ser)
+	}
+	ser.U64(om.Size)
+	ser.U64(om.CreatedAt)
+	ser.U64(om.UpdatedAt)
+	om.ObjectType.MarshalBCS(ser)
+}
+func (om *ObjectMeta) UnmarshalBCS(des *bcs.Deserializer) {
+	om.ID.UnmarshalBCS(des)
+	om.Owner.UnmarshalBCS(des)
+	om.Flag = des.U8()
+	om.StateRoot = nil
+	if des.Bool() {
+		om.StateRoot = &H256{}
+		om.StateRoot.UnmarshalBCS(des)
+	}
+	om.Size = des.U64()
+	om.CreatedAt = des.U64()
+	om.UpdatedAt = des.U64()
+	om.ObjectType.UnmarshalBCS(des)
+}
+
+//pub struct ObjectState {
+//pub metadata: ObjectMeta,
+//pub value: Vec<u8>,
+//}
+
+// ObjectState is an object as stored in the state tree, Value is the BCS of the object value
+type ObjectState struct {
+	Metadata ObjectMeta `json:"metadata"`
+	Value    []byte     `json:"value"`
+}
+
+func (os *ObjectState) MarshalBCS(ser *bcs.Serializer) {
+	os.Metadata.MarshalBCS(ser)
+	ser.WriteBytes(os.Value)
+}
+func (os *ObjectState) UnmarshalBCS(des *bcs.Deserializer) {
+	os.Metadata.UnmarshalBCS(des)
+	os.Value = des.ReadBytes()
+}
+
+// ValueHash is the hash of the object state in the leaf of the state tree, sha3_256 of its BCS
+func (os *ObjectState) ValueHash() (H256, error) {
+	encoded, err := bcs.Serialize(os)
+	if err != nil {
+		return H256{}, err
+	}
+	return NewH256(utils.Sha3256(encoded))
+}
+
+// ObjectStateView is the object state returned by rooch_getStates, the decoded value and display
+// fields are only set when requested in the state options
+type ObjectStateView struct {
+	ObjectState
+	OwnerBitcoinAddress string          `json:"owner_bitcoin_address"`
+	DecodedValue        json.RawMessage `json:"decoded_value"`
+	DisplayFields       json.RawMessage `json:"display_fields"`
+}
+
+// UnmarshalJSON converts the ObjectStateView from the ObjectStateView of the RPC
+func (ov *ObjectStateView) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		ID                  ObjectID        `json:"id"`
+		Owner               RoochAddress    `json:"owner"`
+		OwnerBitcoinAddress *string         `json:"owner_bitcoin_address"`
+		Flag                uint8           `json:"flag"`
+		StateRoot           *H256           `json:"state_root"`
+		Size                strU64          `json:"size"`
+		CreatedAt           strU64          `json:"created_at"`
+		UpdatedAt           strU64          `json:"updated_at"`
+		ObjectType          string          `json:"object_type"`
+		Value               hexBytes        `json:"value"`
+		DecodedValue        json.RawMessage `json:"decoded_value"`
+		DisplayFields       json.RawMessage `json:"display_fields"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to ObjectStateView: %w", err)
+	}
+	objectType, err := ParseTypeTag(data.ObjectType)
+	if err != nil {
+		return err
+	}
+	ov.Metadata = ObjectMeta{
+		ID:         data.ID,
+		Owner:      data.Owner,
+		Flag:       data.Flag,
+		StateRoot:  data.StateRoot,
+		Size:       uint64(data.Size),
+		CreatedAt:  uint64(data.CreatedAt),
+		UpdatedAt:  uint64(data.UpdatedAt),
+		ObjectType: *objectType,
+	}
+	ov.Value = data.Value
+	ov.OwnerBitcoinAddress = ""
+	if data.OwnerBitcoinAddress != nil {
+		ov.OwnerBitcoinAddress = *data.OwnerBitcoinAddress
+	}
+	ov.DecodedValue = data.DecodedValue
+	ov.DisplayFields = data.DisplayFields
+	return nil
+}
+
+// DecodeValue decodes the object value into v, a typed Move struct view such as [CoinStore], from the
+// BCS value when v is a [bcs.Unmarshaler] and from the decoded value otherwise
+func (ov *ObjectStateView) DecodeValue(v any) error {
+	if value, ok := v.(bcs.Unmarshaler); ok && ov.Value != nil {
+		return bcs.Deserialize(value, ov.Value)
+	}
+	if len(ov.DecodedValue) == 0 || string(ov.DecodedValue) == "null" {
+		return fmt.Errorf("object %s has no decoded value", ov.Metadata.ID.String())
+	}
+	return json.Unmarshal(ov.DecodedValue, v)
+}
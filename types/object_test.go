@@ -0,0 +1,97 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectIDDerivation(t *testing.T) {
+	t.Run("Named object", func(t *testing.T) {
+		st := &StructTag{Address: AddressTwo, Module: "timestamp", Name: "Timestamp"}
+		id := NamedObjectID(st)
+		assert.Equal(t, "0x3a7dfe7a9a5cd608810b5ebd60c7adf7316667b17ad5ae703af301b74310bcca", id.String())
+
+		legacy, err := StructTagToObjectID(st)
+		assert.NoError(t, err)
+		assert.True(t, id.Equals(&legacy))
+	})
+
+	t.Run("Named object with type params", func(t *testing.T) {
+		st := &StructTag{
+			Address:    AddressThree,
+			Module:     "coin_store",
+			Name:       "CoinStore",
+			TypeParams: []TypeTag{{Value: &StructTag{Address: AddressThree, Module: "gas_coin", Name: "RGas"}}},
+		}
+		id := NamedObjectID(st)
+		assert.Equal(t, "0xfdda11f9cc18bb30973779eb3610329d7e0e3c6ecce05b4d77b5a839063bff66", id.String())
+	})
+
+	t.Run("Custom and child objects", func(t *testing.T) {
+		st := &StructTag{Address: AddressThree, Module: "coin_store", Name: "CoinStore"}
+		idBcs, _ := bcs.SerializeU64(1)
+		custom := CustomObjectID(idBcs, st)
+		assert.Equal(t, 1, len(custom.Address))
+		other := CustomObjectID(idBcs[:4], st)
+		assert.False(t, custom.Equals(&other))
+
+		account := AccountNamedObjectID(AddressThree, st)
+		named := NamedObjectID(st)
+		assert.False(t, named.Equals(&account))
+
+		child := CustomChildObjectID(account, idBcs, st)
+		assert.Equal(t, 2, len(child.Address))
+		assert.Equal(t, custom.Address[0], child.Address[1])
+
+		parent, ok := child.Parent()
+		assert.True(t, ok)
+		assert.True(t, parent.Equals(&account))
+
+		field := account.FieldObjectID(idBcs, &TypeTag{Value: &U64Tag{}})
+		assert.Equal(t, DeriveFieldKey(idBcs, &TypeTag{Value: &U64Tag{}}), field.Address[1])
+	})
+
+	t.Run("Root", func(t *testing.T) {
+		root := NewObjectID(nil)
+		assert.True(t, root.IsRoot())
+		_, ok := root.Parent()
+		assert.False(t, ok)
+
+		top := NamedObjectID(&StructTag{Address: AddressTwo, Module: "timestamp", Name: "Timestamp"})
+		parent, ok := top.Parent()
+		assert.True(t, ok)
+		assert.True(t, parent.IsRoot())
+	})
+}
+
+func TestObjectIDRoundTrip(t *testing.T) {
+	st := &StructTag{Address: AddressThree, Module: "coin_store", Name: "CoinStore"}
+	named := NamedObjectID(st)
+	id := named.FieldObjectID([]byte{1}, &TypeTag{Value: &U8Tag{}})
+
+	t.Run("String", func(t *testing.T) {
+		parsed, err := ParseObjectID(id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, id, parsed)
+
+		short, err := ParseObjectID("0x3")
+		assert.NoError(t, err)
+		assert.Equal(t, []RoochAddress{AddressThree}, short.Address)
+
+		_, err = ParseObjectID("0x" + id.String()[4:])
+		assert.Error(t, err)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(&id)
+		assert.NoError(t, err)
+		assert.Equal(t, `"`+id.String()+`"`, string(data))
+
+		var decoded ObjectID
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, id, decoded)
+	})
+}
@@ -0,0 +1,253 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+var ErrInvalidStateProof = errors.New("invalid state proof")
+
+// SparseMerklePlaceholderHash is the hash of an empty subtree of the state tree, the literal
+// SPARSE_MERKLE_PLACEHOLDER_HASH padded with zeros
+var SparseMerklePlaceholderHash = func() H256 {
+	var h H256
+	copy(h[:], "SPARSE_MERKLE_PLACEHOLDER_HASH")
+	return h
+}()
+
+// SparseMerkleInternalHash is the hash of an internal node of the state tree, sha3_256(left || right)
+func SparseMerkleInternalHash(left, right H256) H256 {
+	var h H256
+	copy(h[:], utils.Sha3256(append(left.Bytes(), right.Bytes()...)))
+	return h
+}
+
+//pub struct SparseMerkleLeafNode {
+//key: H256,
+//value_hash: H256,
+//}
+
+// SparseMerkleLeaf is a leaf of the state tree, the key is the field key and the value hash the
+// [ObjectState.ValueHash] of the field
+type SparseMerkleLeaf struct {
+	Key       H256 `json:"key"`
+	ValueHash H256 `json:"value_hash"`
+}
+
+// Hash is the hash of the leaf node, sha3_256(key || value_hash)
+func (l *SparseMerkleLeaf) Hash() H256 {
+	return SparseMerkleInternalHash(l.Key, l.ValueHash)
+}
+
+func (l *SparseMerkleLeaf) MarshalBCS(ser *bcs.Serializer) {
+	l.Key.MarshalBCS(ser)
+	l.ValueHash.MarshalBCS(ser)
+}
+func (l *SparseMerkleLeaf) UnmarshalBCS(des *bcs.Deserializer) {
+	l.Key.UnmarshalBCS(des)
+	l.ValueHash.UnmarshalBCS(des)
+}
+
+//pub struct SparseMerkleProof {
+//leaf: Option<SparseMerkleLeafNode>,
+//siblings: Vec<H256>,
+//}
+
+// SparseMerkleProof proves a key is, or is not, in the state tree. Leaf is the leaf found on the path
+// of the key, nil if the path ends in an empty subtree. The siblings are ordered from the leaf to the root
+type SparseMerkleProof struct {
+	Leaf     *SparseMerkleLeaf `json:"leaf"`
+	Siblings []H256            `json:"siblings"`
+}
+
+func (p *SparseMerkleProof) MarshalBCS(ser *bcs.Serializer) {
+	if p.Leaf == nil {
+		ser.Bool(false)
+	} else {
+		ser.Bool(true)
+		p.Leaf.MarshalBCS(ser)
+	}
+	bcs.SerializeSequence(p.Siblings, ser)
+}
+func (p *SparseMerkleProof) UnmarshalBCS(des *bcs.Deserializer) {
+	p.Leaf = nil
+	if des.Bool() {
+		p.Leaf = &SparseMerkleLeaf{}
+		p.Leaf.UnmarshalBCS(des)
+	}
+	p.Siblings = bcs.DeserializeSequence[H256](des)
+}
+
+// UnmarshalJSON converts the proof from the hex of its BCS, as returned by the RPC
+func (p *SparseMerkleProof) UnmarshalJSON(b []byte) error {
+	var encoded hexBytes
+	if err := json.Unmarshal(b, &encoded); err != nil {
+		return fmt.Errorf("failed to convert input to SparseMerkleProof: %w", err)
+	}
+	return bcs.Deserialize(p, encoded)
+}
+
+// keyBit returns the bit of key at depth, from the most significant bit
+func keyBit(key H256, depth int) bool {
+	return key[depth/8]&(0x80>>(depth%8)) != 0
+}
+
+// RootHash computes the root of the state tree from the leaf of the proof and its siblings along the path of key
+func (p *SparseMerkleProof) RootHash(key H256) (H256, error) {
+	if len(p.Siblings) > H256Length*8 {
+		return H256{}, fmt.Errorf("%w: %d siblings is more than the key length", ErrInvalidStateProof, len(p.Siblings))
+	}
+	hash := SparseMerklePlaceholderHash
+	if p.Leaf != nil {
+		hash = p.Leaf.Hash()
+	}
+	for i, sibling := range p.Siblings {
+		if keyBit(key, len(p.Siblings)-1-i) {
+			hash = SparseMerkleInternalHash(sibling, hash)
+		} else {
+			hash = SparseMerkleInternalHash(hash, sibling)
+		}
+	}
+	return hash, nil
+}
+
+// VerifyInclusion checks the proof shows key has the value hash in the state tree with the expected root
+func (p *SparseMerkleProof) VerifyInclusion(expectedRoot H256, key H256, valueHash H256) error {
+	if p.Leaf == nil {
+		return fmt.Errorf("%w: expected an inclusion proof of %s", ErrInvalidStateProof, key)
+	}
+	if p.Leaf.Key != key {
+		return fmt.Errorf("%w: the proof is for key %s, not %s", ErrInvalidStateProof, p.Leaf.Key, key)
+	}
+	if p.Leaf.ValueHash != valueHash {
+		return fmt.Errorf("%w: value hash %s of %s does not match %s", ErrInvalidStateProof, p.Leaf.ValueHash, key, valueHash)
+	}
+	return p.verifyRoot(expectedRoot, key)
+}
+
+// VerifyExclusion checks the proof shows key is not in the state tree with the expected root, the
+// path of key ends either in an empty subtree or in the leaf of another key
+func (p *SparseMerkleProof) VerifyExclusion(expectedRoot H256, key H256) error {
+	if p.Leaf != nil {
+		if p.Leaf.Key == key {
+			return fmt.Errorf("%w: expected an exclusion proof of %s", ErrInvalidStateProof, key)
+		}
+		for depth := range p.Siblings {
+			if keyBit(key, depth) != keyBit(p.Leaf.Key, depth) {
+				return fmt.Errorf("%w: the leaf %s is not on the path of %s", ErrInvalidStateProof, p.Leaf.Key, key)
+			}
+		}
+	}
+	return p.verifyRoot(expectedRoot, key)
+}
+
+func (p *SparseMerkleProof) verifyRoot(expectedRoot H256, key H256) error {
+	root, err := p.RootHash(key)
+	if err != nil {
+		return err
+	}
+	if root != expectedRoot {
+		return fmt.Errorf("%w: root %s of %s does not match the expected root %s", ErrInvalidStateProof, root, key, expectedRoot)
+	}
+	return nil
+}
+
+// StateProof proves the state of an object under a global state root. An object is a field of its
+// parent, so there is one proof per level of the object ID, checked against the state root of the
+// parent object. Parents are the states of the ancestor objects from the top level object
+type StateProof struct {
+	Parents []ObjectState       `json:"parents"`
+	Proofs  []SparseMerkleProof `json:"proofs"`
+}
+
+// UnmarshalJSON converts the StateProof from the RPC, the parents are object state views and the proofs hex BCS
+func (sp *StateProof) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		Parents []ObjectStateView   `json:"parents"`
+		Proofs  []SparseMerkleProof `json:"proofs"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to StateProof: %w", err)
+	}
+	sp.Parents = make([]ObjectState, len(data.Parents))
+	for i := range data.Parents {
+		sp.Parents[i] = data.Parents[i].ObjectState
+	}
+	sp.Proofs = data.Proofs
+	return nil
+}
+
+// ObjectStateWithProof is the state of an object with its proof under StateRoot, as returned by
+// rooch_getStatesWithProof. State is nil when the object does not exist
+type ObjectStateWithProof struct {
+	ID        ObjectID         `json:"id"`
+	StateRoot H256             `json:"state_root"`
+	State     *ObjectStateView `json:"state"`
+	Proof     StateProof       `json:"proof"`
+}
+
+// Verify checks the state against a trusted state root, e.g. the StateRoot of a verified
+// [TransactionExecutionInfo], the state root returned by the node is not trusted
+func (s *ObjectStateWithProof) Verify(trustedRoot H256) error {
+	if s.StateRoot != trustedRoot {
+		return fmt.Errorf("%w: state root %s is not the trusted root %s", ErrInvalidStateProof, s.StateRoot, trustedRoot)
+	}
+	var state *ObjectState
+	if s.State != nil {
+		state = &s.State.ObjectState
+	}
+	return VerifyObjectState(trustedRoot, &s.ID, state, &s.Proof)
+}
+
+// VerifyObjectState checks the object with the ID has the state under the state root, a nil state checks
+// that the object does not exist
+func VerifyObjectState(stateRoot H256, id *ObjectID, state *ObjectState, proof *StateProof) error {
+	levels := len(id.Address)
+	if levels == 0 {
+		return fmt.Errorf("%w: the root object is not a field", ErrInvalidStateProof)
+	}
+	if len(proof.Proofs) != levels || len(proof.Parents) != levels-1 {
+		return fmt.Errorf("%w: %d proofs and %d parents for an object at depth %d", ErrInvalidStateProof, len(proof.Proofs), len(proof.Parents), levels)
+	}
+
+	root := stateRoot
+	for level := 0; level < levels; level++ {
+		key := H256(id.Address[level])
+		if level == levels-1 {
+			if state == nil {
+				return proof.Proofs[level].VerifyExclusion(root, key)
+			}
+			if !state.Metadata.ID.Equals(id) {
+				return fmt.Errorf("%w: state of %s is not the state of %s", ErrInvalidStateProof, state.Metadata.ID.String(), id.String())
+			}
+			return verifyFieldState(&proof.Proofs[level], root, key, state)
+		}
+
+		parent := &proof.Parents[level]
+		parentID := NewObjectID(id.Address[:level+1])
+		if !parent.Metadata.ID.Equals(&parentID) {
+			return fmt.Errorf("%w: parent %s is not %s", ErrInvalidStateProof, parent.Metadata.ID.String(), parentID.String())
+		}
+		if err := verifyFieldState(&proof.Proofs[level], root, key, parent); err != nil {
+			return err
+		}
+		if parent.Metadata.StateRoot == nil {
+			return fmt.Errorf("%w: parent %s has no fields", ErrInvalidStateProof, parentID.String())
+		}
+		root = *parent.Metadata.StateRoot
+	}
+	return nil
+}
+
+func verifyFieldState(proof *SparseMerkleProof, root H256, key H256, state *ObjectState) error {
+	valueHash, err := state.ValueHash()
+	if err != nil {
+		return err
+	}
+	return proof.VerifyInclusion(root, key, valueHash)
+}
@@ -0,0 +1,210 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+// testStateTree is a stand-in for the state tree of the node, a binary sparse Merkle tree whose
+// leaves are at the shortest prefix of their key that is unique
+type testStateTree map[H256]H256
+
+func (tree testStateTree) subtree(prefix H256, depth int) []SparseMerkleLeaf {
+	var leaves []SparseMerkleLeaf
+	for key, valueHash := range tree {
+		onPath := true
+		for i := 0; i < depth; i++ {
+			if keyBit(key, i) != keyBit(prefix, i) {
+				onPath = false
+				break
+			}
+		}
+		if onPath {
+			leaves = append(leaves, SparseMerkleLeaf{Key: key, ValueHash: valueHash})
+		}
+	}
+	return leaves
+}
+
+func (tree testStateTree) hash(prefix H256, depth int) H256 {
+	leaves := tree.subtree(prefix, depth)
+	switch len(leaves) {
+	case 0:
+		return SparseMerklePlaceholderHash
+	case 1:
+		return leaves[0].Hash()
+	}
+	left, right := prefix, prefix
+	left[depth/8] &^= 0x80 >> (depth % 8)
+	right[depth/8] |= 0x80 >> (depth % 8)
+	return SparseMerkleInternalHash(tree.hash(left, depth+1), tree.hash(right, depth+1))
+}
+
+func (tree testStateTree) root() H256 {
+	return tree.hash(H256{}, 0)
+}
+
+func (tree testStateTree) prove(key H256) *SparseMerkleProof {
+	proof := &SparseMerkleProof{}
+	for depth := 0; ; depth++ {
+		leaves := tree.subtree(key, depth)
+		if len(leaves) <= 1 {
+			if len(leaves) == 1 {
+				proof.Leaf = &leaves[0]
+			}
+			break
+		}
+		sibling := key
+		sibling[depth/8] ^= 0x80 >> (depth % 8)
+		// Siblings are ordered from the leaf to the root
+		proof.Siblings = append([]H256{tree.hash(sibling, depth+1)}, proof.Siblings...)
+	}
+	return proof
+}
+
+func testObjectState(t *testing.T, id ObjectID, stateRoot *H256, value byte) *ObjectState {
+	owner, err := address.NewRoochAddress("0x42")
+	assert.NoError(t, err)
+	objectType, err := ParseTypeTag("0x3::coin_store::CoinStore<0x3::gas_coin::RGas>")
+	assert.NoError(t, err)
+	return &ObjectState{
+		Metadata: ObjectMeta{ID: id, Owner: *owner, StateRoot: stateRoot, Size: 1, CreatedAt: 10, UpdatedAt: 20, ObjectType: *objectType},
+		Value:    []byte{value},
+	}
+}
+
+func (tree testStateTree) insert(t *testing.T, state *ObjectState) {
+	valueHash, err := state.ValueHash()
+	assert.NoError(t, err)
+	tree[H256(state.Metadata.ID.Address[len(state.Metadata.ID.Address)-1])] = valueHash
+}
+
+func TestSparseMerkleProof(t *testing.T) {
+	tree := testStateTree{}
+	for i := byte(1); i <= 20; i++ {
+		tree[testH256(i*11)] = testH256(i)
+	}
+	root := tree.root()
+
+	for key, valueHash := range tree {
+		proof := tree.prove(key)
+		assert.NoError(t, proof.VerifyInclusion(root, key, valueHash))
+		assert.ErrorIs(t, proof.VerifyInclusion(root, key, testH256(0xff)), ErrInvalidStateProof)
+		assert.ErrorIs(t, proof.VerifyExclusion(root, key), ErrInvalidStateProof)
+	}
+
+	t.Run("exclusion", func(t *testing.T) {
+		for _, b := range []byte{0x01, 0x0c, 0x80, 0xfe} {
+			missing := testH256(b)
+			proof := tree.prove(missing)
+			assert.NoError(t, proof.VerifyExclusion(root, missing))
+			if proof.Leaf != nil {
+				assert.ErrorIs(t, proof.VerifyInclusion(root, missing, proof.Leaf.ValueHash), ErrInvalidStateProof)
+			}
+		}
+	})
+
+	t.Run("wrong root", func(t *testing.T) {
+		key := testH256(11)
+		assert.ErrorIs(t, tree.prove(key).VerifyInclusion(testH256(0x01), key, tree[key]), ErrInvalidStateProof)
+	})
+
+	t.Run("empty tree", func(t *testing.T) {
+		empty := testStateTree{}
+		assert.Equal(t, SparseMerklePlaceholderHash, empty.root())
+		assert.NoError(t, empty.prove(testH256(1)).VerifyExclusion(SparseMerklePlaceholderHash, testH256(1)))
+	})
+}
+
+func TestVerifyObjectState(t *testing.T) {
+	parentID := NewObjectID([]RoochAddress{RoochAddress(testH256(0x21))})
+	childID := parentID.ChildID(RoochAddress(testH256(0x42)))
+
+	fields := testStateTree{}
+	child := testObjectState(t, childID, nil, 7)
+	fields.insert(t, child)
+	fields[testH256(0x43)] = testH256(0x01)
+	fieldsRoot := fields.root()
+
+	global := testStateTree{}
+	parent := testObjectState(t, parentID, &fieldsRoot, 1)
+	global.insert(t, parent)
+	global[testH256(0x22)] = testH256(0x02)
+	stateRoot := global.root()
+
+	proof := &StateProof{
+		Parents: []ObjectState{*parent},
+		Proofs:  []SparseMerkleProof{*global.prove(H256(parentID.Address[0])), *fields.prove(H256(childID.Address[1]))},
+	}
+	assert.NoError(t, VerifyObjectState(stateRoot, &childID, child, proof))
+	assert.NoError(t, VerifyObjectState(stateRoot, &parentID, parent, &StateProof{Proofs: proof.Proofs[:1]}))
+
+	t.Run("tampered state", func(t *testing.T) {
+		tampered := *child
+		tampered.Value = []byte{8}
+		assert.ErrorIs(t, VerifyObjectState(stateRoot, &childID, &tampered, proof), ErrInvalidStateProof)
+
+		tamperedParent := *parent
+		tamperedParent.Metadata.Size = 2
+		tamperedProof := &StateProof{Parents: []ObjectState{tamperedParent}, Proofs: proof.Proofs}
+		assert.ErrorIs(t, VerifyObjectState(stateRoot, &childID, child, tamperedProof), ErrInvalidStateProof)
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		missingID := parentID.ChildID(RoochAddress(testH256(0x44)))
+		missingProof := &StateProof{
+			Parents: proof.Parents,
+			Proofs:  []SparseMerkleProof{proof.Proofs[0], *fields.prove(H256(missingID.Address[1]))},
+		}
+		assert.NoError(t, VerifyObjectState(stateRoot, &missingID, nil, missingProof))
+		assert.Error(t, VerifyObjectState(stateRoot, &childID, nil, proof))
+	})
+
+	t.Run("wrong depth", func(t *testing.T) {
+		assert.ErrorIs(t, VerifyObjectState(stateRoot, &childID, child, &StateProof{Proofs: proof.Proofs}), ErrInvalidStateProof)
+	})
+}
+
+func TestObjectStateWithProofJSON(t *testing.T) {
+	id := NewObjectID([]RoochAddress{RoochAddress(testH256(0x21))})
+	state := testObjectState(t, id, nil, 7)
+	global := testStateTree{}
+	global.insert(t, state)
+	global[testH256(0x22)] = testH256(0x02)
+	stateRoot := global.root()
+
+	proof, err := bcs.Serialize(global.prove(testH256(0x21)))
+	assert.NoError(t, err)
+	testJson := `{
+  "id": "` + id.String() + `",
+  "state_root": "` + stateRoot.String() + `",
+  "state": {
+    "id": "` + id.String() + `",
+    "owner": "0x0000000000000000000000000000000000000000000000000000000000000042",
+    "owner_bitcoin_address": null,
+    "flag": 0,
+    "state_root": null,
+    "size": "1",
+    "created_at": "10",
+    "updated_at": "20",
+    "object_type": "0x3::coin_store::CoinStore<0x3::gas_coin::RGas>",
+    "value": "0x07",
+    "decoded_value": null,
+    "display_fields": null
+  },
+  "proof": {"parents": [], "proofs": ["0x` + hex.EncodeToString(proof) + `"]}
+}`
+	result := &ObjectStateWithProof{}
+	assert.NoError(t, json.Unmarshal([]byte(testJson), result))
+	assert.Equal(t, *state, result.State.ObjectState)
+	assert.NoError(t, result.Verify(stateRoot))
+	assert.ErrorIs(t, result.Verify(testH256(0x01)), ErrInvalidStateProof)
+
+	result.State.Value = []byte{8}
+	assert.ErrorIs(t, result.Verify(stateRoot), ErrInvalidStateProof)
+}
@@ -1,13 +1,23 @@
 package types
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+
 	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/utils"
 
 	"github.com/rooch-network/rooch-go-sdk/bcs"
 )
 
+var (
+	ErrTxHashMismatch          = errors.New("transaction hash mismatch")
+	ErrInvalidTxOrderSignature = errors.New("invalid tx order signature")
+)
+
 type Transaction struct {
 	Data TransactionData `json:"data"`
 	//Data          crypto.TransactionData `json:"data"`
@@ -42,14 +52,113 @@ func (t *Transaction) GetData() crypto.TransactionData {
 	return &t.Data
 }
 
+//pub struct TransactionSequenceInfo {
+//pub tx_order: u64,
+//pub tx_order_signature: Vec<u8>,
+//pub tx_accumulator_root: H256,
+//pub tx_timestamp: u64,
+//pub tx_accumulator_frozen_subtree_roots: Vec<H256>,
+//pub tx_accumulator_num_leaves: u64,
+//pub tx_accumulator_num_nodes: u64,
+//}
+
+// TransactionSequenceInfo is the position of a transaction in the ledger, signed by the sequencer
 type TransactionSequenceInfo struct {
-	TxOrder                         uint64   `json:"tx_order"`
-	TxOrderSignature                []byte   `json:"tx_order_signature"`
-	TxAccumulatorRoot               string   `json:"tx_accumulator_root"`
-	TxTimestamp                     uint64   `json:"tx_timestamp"`
-	TxAccumulatorFrozenSubtreeRoots []string `json:"tx_accumulator_frozen_subtree_roots"`
-	TxAccumulatorNumLeaves          uint64   `json:"tx_accumulator_num_leaves"`
-	TxAccumulatorNumNodes           uint64   `json:"tx_accumulator_num_nodes"`
+	TxOrder                         uint64 `json:"tx_order"`
+	TxOrderSignature                []byte `json:"tx_order_signature"`
+	TxAccumulatorRoot               H256   `json:"tx_accumulator_root"`
+	TxTimestamp                     uint64 `json:"tx_timestamp"`
+	TxAccumulatorFrozenSubtreeRoots []H256 `json:"tx_accumulator_frozen_subtree_roots"`
+	TxAccumulatorNumLeaves          uint64 `json:"tx_accumulator_num_leaves"`
+	TxAccumulatorNumNodes           uint64 `json:"tx_accumulator_num_nodes"`
+}
+
+func (si *TransactionSequenceInfo) MarshalBCS(ser *bcs.Serializer) {
+	ser.U64(si.TxOrder)
+	ser.WriteBytes(si.TxOrderSignature)
+	si.TxAccumulatorRoot.MarshalBCS(ser)
+	ser.U64(si.TxTimestamp)
+	bcs.SerializeSequence(si.TxAccumulatorFrozenSubtreeRoots, ser)
+	ser.U64(si.TxAccumulatorNumLeaves)
+	ser.U64(si.TxAccumulatorNumNodes)
+}
+func (si *TransactionSequenceInfo) UnmarshalBCS(des *bcs.Deserializer) {
+	si.TxOrder = des.U64()
+	si.TxOrderSignature = des.ReadBytes()
+	si.TxAccumulatorRoot.UnmarshalBCS(des)
+	si.TxTimestamp = des.U64()
+	si.TxAccumulatorFrozenSubtreeRoots = bcs.DeserializeSequence[H256](des)
+	si.TxAccumulatorNumLeaves = des.U64()
+	si.TxAccumulatorNumNodes = des.U64()
+}
+
+// UnmarshalJSON converts the TransactionSequenceInfo from the TransactionSequenceInfoView of the RPC
+func (si *TransactionSequenceInfo) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		TxOrder                         strU64   `json:"tx_order"`
+		TxOrderSignature                hexBytes `json:"tx_order_signature"`
+		TxAccumulatorRoot               H256     `json:"tx_accumulator_root"`
+		TxTimestamp                     strU64   `json:"tx_timestamp"`
+		TxAccumulatorFrozenSubtreeRoots []H256   `json:"tx_accumulator_frozen_subtree_roots"`
+		TxAccumulatorNumLeaves          strU64   `json:"tx_accumulator_num_leaves"`
+		TxAccumulatorNumNodes           strU64   `json:"tx_accumulator_num_nodes"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	si.TxOrder = uint64(data.TxOrder)
+	si.TxOrderSignature = data.TxOrderSignature
+	si.TxAccumulatorRoot = data.TxAccumulatorRoot
+	si.TxTimestamp = uint64(data.TxTimestamp)
+	si.TxAccumulatorFrozenSubtreeRoots = data.TxAccumulatorFrozenSubtreeRoots
+	si.TxAccumulatorNumLeaves = uint64(data.TxAccumulatorNumLeaves)
+	si.TxAccumulatorNumNodes = uint64(data.TxAccumulatorNumNodes)
+	return nil
+}
+
+// AccumulatorInfo returns the state of the transaction accumulator after the transaction was appended
+func (si *TransactionSequenceInfo) AccumulatorInfo() AccumulatorInfo {
+	return AccumulatorInfo{
+		AccumulatorRoot:    si.TxAccumulatorRoot,
+		FrozenSubtreeRoots: si.TxAccumulatorFrozenSubtreeRoots,
+		NumLeaves:          si.TxAccumulatorNumLeaves,
+		NumNodes:           si.TxAccumulatorNumNodes,
+	}
+}
+
+// TxOrderWitnessHash returns the hash signed by the sequencer for the tx order, sha3_256(tx_hash || tx_order)
+// with the tx order in little endian
+func TxOrderWitnessHash(txHash H256, txOrder uint64) H256 {
+	witness := binary.LittleEndian.AppendUint64(append([]byte{}, txHash.Bytes()...), txOrder)
+	var h H256
+	copy(h[:], utils.Sha3256(witness))
+	return h
+}
+
+// VerifyTxOrderSignature checks the TxOrderSignature is the signature of the sequencer for the tx order
+// of txHash. The signature is flag || signature || public key, the public key must be the sequencer's
+func (si *TransactionSequenceInfo) VerifyTxOrderSignature(txHash H256, sequencer crypto.PublicKey) error {
+	publicKey := sequencer.ToBytes()
+	signatureLength := len(si.TxOrderSignature) - 1 - len(publicKey)
+	if signatureLength <= 0 {
+		return fmt.Errorf("%w: invalid length %d", ErrInvalidTxOrderSignature, len(si.TxOrderSignature))
+	}
+	if si.TxOrderSignature[0] != sequencer.Flag() {
+		return fmt.Errorf("%w: signature scheme flag %d does not match the sequencer key", ErrInvalidTxOrderSignature, si.TxOrderSignature[0])
+	}
+	if !bytes.Equal(si.TxOrderSignature[1+signatureLength:], publicKey) {
+		return fmt.Errorf("%w: not signed by the sequencer", ErrInvalidTxOrderSignature)
+	}
+	witnessHash := TxOrderWitnessHash(txHash, si.TxOrder)
+	ok, err := sequencer.Verify(witnessHash.Bytes(), si.TxOrderSignature[1:1+signatureLength])
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTxOrderSignature, err)
+	}
+	if !ok {
+		return fmt.Errorf("%w: signature does not match tx order %d", ErrInvalidTxOrderSignature, si.TxOrder)
+	}
+	return nil
 }
 
 //pub struct TransactionExecutionInfo {
@@ -75,18 +184,54 @@ type TransactionSequenceInfo struct {
 //pub status: KeptVMStatus,
 //}
 
+// TransactionExecutionInfo is the result of the execution of a transaction
 type TransactionExecutionInfo struct {
-	TxHash    string `json:"tx_hash"`
-	StateRoot string `json:"state_root"`
-	Size      uint64 `json:"size"`
-	EventRoot string `json:"event_root"`
-	GasUsed   uint64 `json:"gas_used"`
-	/// The vm status. If it is not `Executed`, this will provide the general error class. Execution
-	/// failures and Move abort's receive more detailed information. But other errors are generally
-	/// categorized with no status code or other information
-	//pub status: KeptVMStatus,
-	//Status string `json:"status"`
-	Status json.RawMessage `json:"status"`
+	TxHash    H256         `json:"tx_hash"`
+	StateRoot H256         `json:"state_root"`
+	Size      uint64       `json:"size"`
+	EventRoot H256         `json:"event_root"`
+	GasUsed   uint64       `json:"gas_used"`
+	Status    KeptVMStatus `json:"status"`
+}
+
+func (ei *TransactionExecutionInfo) MarshalBCS(ser *bcs.Serializer) {
+	ei.TxHash.MarshalBCS(ser)
+	ei.StateRoot.MarshalBCS(ser)
+	ser.U64(ei.Size)
+	ei.EventRoot.MarshalBCS(ser)
+	ser.U64(ei.GasUsed)
+	ei.Status.MarshalBCS(ser)
+}
+func (ei *TransactionExecutionInfo) UnmarshalBCS(des *bcs.Deserializer) {
+	ei.TxHash.UnmarshalBCS(des)
+	ei.StateRoot.UnmarshalBCS(des)
+	ei.Size = des.U64()
+	ei.EventRoot.UnmarshalBCS(des)
+	ei.GasUsed = des.U64()
+	ei.Status.UnmarshalBCS(des)
+}
+
+// UnmarshalJSON converts the TransactionExecutionInfo from the TransactionExecutionInfoView of the RPC
+func (ei *TransactionExecutionInfo) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		TxHash    H256         `json:"tx_hash"`
+		StateRoot H256         `json:"state_root"`
+		Size      strU64       `json:"size"`
+		EventRoot H256         `json:"event_root"`
+		GasUsed   strU64       `json:"gas_used"`
+		Status    KeptVMStatus `json:"status"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	ei.TxHash = data.TxHash
+	ei.StateRoot = data.StateRoot
+	ei.Size = uint64(data.Size)
+	ei.EventRoot = data.EventRoot
+	ei.GasUsed = uint64(data.GasUsed)
+	ei.Status = data.Status
+	return nil
 }
 
 //pub struct L1Block {
@@ -99,19 +244,17 @@ type L1Block struct {
 	ChainId     MultiChainIDVariant `json:"chain_id"`
 	BlockHeight uint64              `json:"block_height"`
 	BlockHash   []byte              `json:"block_hash"`
-	//GasUsed      uint64 `json:"gas_used"`
-	/// The vm status. If it is not `Executed`, this will provide the general error class. Execution
-	/// failures and Move abort's receive more detailed information. But other errors are generally
-	/// categorized with no status code or other information
-	//pub status: KeptVMStatus,
-	//Status string `json:"status"`
-	//Status json.RawMessage `json:"status"`
 }
 
 func (lb *L1Block) LedgerTxDataType() LedgerTxDataVariant {
 	return LedgerTxDataVariantL1Block
 }
 
+// TxHash is the sha3_256 of the BCS of the block
+func (lb *L1Block) TxHash() (H256, error) {
+	return sha3BCS(lb)
+}
+
 func (lb *L1Block) MarshalBCS(ser *bcs.Serializer) {
 	ser.U64(uint64(lb.ChainId))
 	ser.U64(lb.BlockHeight)
@@ -123,6 +266,23 @@ func (lb *L1Block) UnmarshalBCS(des *bcs.Deserializer) {
 	lb.BlockHash = des.ReadBytes()
 }
 
+// UnmarshalJSON converts the L1Block from the l1_block LedgerTxDataView of the RPC
+func (lb *L1Block) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		ChainId     strU64   `json:"chain_id"`
+		BlockHeight strU64   `json:"block_height"`
+		BlockHash   hexBytes `json:"block_hash"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	lb.ChainId = MultiChainIDVariant(data.ChainId)
+	lb.BlockHeight = uint64(data.BlockHeight)
+	lb.BlockHash = data.BlockHash
+	return nil
+}
+
 //pub struct L1Transaction {
 //pub chain_id: MultiChainID,
 //pub block_hash: Vec<u8>,
@@ -134,19 +294,17 @@ type L1Transaction struct {
 	ChainId   MultiChainIDVariant `json:"chain_id"`
 	BlockHash []byte              `json:"block_hash"`
 	TxID      []byte              `json:"txid"`
-	//GasUsed      uint64 `json:"gas_used"`
-	/// The vm status. If it is not `Executed`, this will provide the general error class. Execution
-	/// failures and Move abort's receive more detailed information. But other errors are generally
-	/// categorized with no status code or other information
-	//pub status: KeptVMStatus,
-	//Status string `json:"status"`
-	//Status json.RawMessage `json:"status"`
 }
 
 func (lt *L1Transaction) LedgerTxDataType() LedgerTxDataVariant {
 	return LedgerTxDataVariantL1Tx
 }
 
+// TxHash is the sha3_256 of the BCS of the transaction
+func (lt *L1Transaction) TxHash() (H256, error) {
+	return sha3BCS(lt)
+}
+
 func (lt *L1Transaction) MarshalBCS(ser *bcs.Serializer) {
 	ser.U64(uint64(lt.ChainId))
 	ser.WriteBytes(lt.BlockHash)
@@ -158,6 +316,23 @@ func (lt *L1Transaction) UnmarshalBCS(des *bcs.Deserializer) {
 	lt.TxID = des.ReadBytes()
 }
 
+// UnmarshalJSON converts the L1Transaction from the l1_tx LedgerTxDataView of the RPC
+func (lt *L1Transaction) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		ChainId   strU64   `json:"chain_id"`
+		BlockHash hexBytes `json:"block_hash"`
+		TxID      hexBytes `json:"txid"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	lt.ChainId = MultiChainIDVariant(data.ChainId)
+	lt.BlockHash = data.BlockHash
+	lt.TxID = data.TxID
+	return nil
+}
+
 //pub struct RoochTransaction {
 //pub data: TransactionData,
 //pub authenticator: Authenticator,
@@ -169,51 +344,41 @@ func (lt *L1Transaction) UnmarshalBCS(des *bcs.Deserializer) {
 type RoochTransaction struct {
 	Data          TransactionData      `json:"data"`
 	Authenticator crypto.Authenticator `json:"authenticator"`
-	DataHash      string               `json:"data_hash,omitempty"`
-	//GasUsed      uint64 `json:"gas_used"`
-	/// The vm status. If it is not `Executed`, this will provide the general error class. Execution
-	/// failures and Move abort's receive more detailed information. But other errors are generally
-	/// categorized with no status code or other information
-	//pub status: KeptVMStatus,
-	//Status string `json:"status"`
-	//Status json.RawMessage `json:"status"`
 }
 
 func (rt *RoochTransaction) LedgerTxDataType() LedgerTxDataVariant {
 	return LedgerTxDataVariantL2Tx
 }
 
+// TxHash is the hash of the transaction data, the authenticator is not part of it
+func (rt *RoochTransaction) TxHash() (H256, error) {
+	return sha3BCS(&rt.Data)
+}
+
 func (rt *RoochTransaction) MarshalBCS(ser *bcs.Serializer) {
-	//ser.U64(uint64(rt.Data))
 	rt.Data.MarshalBCS(ser)
 	rt.Authenticator.MarshalBCS(ser)
-	ser.WriteString(rt.DataHash)
 }
 func (rt *RoochTransaction) UnmarshalBCS(des *bcs.Deserializer) {
 	rt.Data.UnmarshalBCS(des)
 	rt.Authenticator.UnmarshalBCS(des)
-	rt.DataHash = des.ReadString()
 }
 
-//pub enum LedgerTxData {
-//L1Block(L1Block),
-//L1Tx(L1Transaction),
-//L2Tx(RoochTransaction),
-//}
-
-//type LedgerTxData struct {
-//	data          LedgerTxData            `json:"data"`
-//	sequence_info TransactionSequenceInfo `json:"sequence_info"`
-//}
-
-//type TransactionPayloadVariant uint32
-//
-//const (
-//	TransactionPayloadVariantScript        TransactionPayloadVariant = 0
-//	TransactionPayloadVariantModuleBundle  TransactionPayloadVariant = 1 // Deprecated
-//	TransactionPayloadVariantEntryFunction TransactionPayloadVariant = 2
-//	TransactionPayloadVariantMultisig      TransactionPayloadVariant = 3
-//)
+// UnmarshalJSON converts the RoochTransaction from the l2_tx LedgerTxDataView of the RPC, the
+// transaction is decoded from the BCS in its raw field
+func (rt *RoochTransaction) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		Raw hexBytes `json:"raw"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	if err := bcs.Deserialize(rt, data.Raw); err != nil {
+		return fmt.Errorf("failed to decode raw l2 transaction: %w", err)
+	}
+	return nil
+}
 
 //#[derive(Clone, Debug, Hash, Eq, PartialEq, Serialize, Deserialize)]
 //pub enum LedgerTxData {
@@ -226,41 +391,53 @@ type LedgerTxDataVariant uint32
 
 const (
 	LedgerTxDataVariantL1Block LedgerTxDataVariant = 0
-	LedgerTxDataVariantL1Tx    LedgerTxDataVariant = 1 // Deprecated
+	LedgerTxDataVariantL1Tx    LedgerTxDataVariant = 1
 	LedgerTxDataVariantL2Tx    LedgerTxDataVariant = 2
 )
 
+// ledgerTxDataTypes are the type names of the LedgerTxDataView variants
+var ledgerTxDataTypes = map[string]LedgerTxDataVariant{
+	"l1_block": LedgerTxDataVariantL1Block,
+	"l1_tx":    LedgerTxDataVariantL1Tx,
+	"l2_tx":    LedgerTxDataVariantL2Tx,
+}
+
 type LedgerTxDataImpl interface {
 	bcs.Struct
 	LedgerTxDataType() LedgerTxDataVariant // This is specifically to ensure that wrong types don't end up here
+	TxHash() (H256, error)                 // TxHash recomputes the hash of the transaction from its BCS
 }
 
-// TransactionPayload the actual instructions of which functions to call on chain
+// LedgerTxData is the transaction stored in the ledger, a [L1Block], [L1Transaction] or [RoochTransaction]
 type LedgerTxData struct {
-	//Payload TransactionPayloadImpl
-	//Variant LedgerTxDataVariant `json:"variant"`
 	TxData LedgerTxDataImpl `json:"tx_data"`
 }
 
-//func (ltd *LedgerTxData) MarshalBCS(ser *bcs.Serializer) {
-//	ser.Uleb128(uint32(ltd.Variant))
-//	ser.Struct(ltd.TxData)
-//}
-//func (ltd *LedgerTxData) UnmarshalBCS(des *bcs.Deserializer) {
-//	variant := LedgerTxDataVariant(des.Uleb128())
-//	switch variant {
-//	case LedgerTxDataVariantL1Block:
-//		ltd.TxData = &L1Block{}
-//	case LedgerTxDataVariantL1Tx:
-//		ltd.TxData = &L1Transaction{}
-//	case LedgerTxDataVariantL2Tx:
-//		ltd.TxData = &RoochTransaction{}
-//	default:
-//		des.SetError(fmt.Errorf("bad variant %d for MultisigTransactionPayload", variant))
-//		return
-//	}
-//	des.Struct(ltd.TxData)
-//}
+// TxHash recomputes the hash of the transaction from its BCS
+func (ltd *LedgerTxData) TxHash() (H256, error) {
+	if ltd.TxData == nil {
+		return H256{}, fmt.Errorf("Ledger tx data is nil")
+	}
+	return ltd.TxData.TxHash()
+}
+
+// L1Block returns the L1 block, nil for other variants
+func (ltd *LedgerTxData) L1Block() *L1Block {
+	lb, _ := ltd.TxData.(*L1Block)
+	return lb
+}
+
+// L1Transaction returns the L1 transaction, nil for other variants
+func (ltd *LedgerTxData) L1Transaction() *L1Transaction {
+	lt, _ := ltd.TxData.(*L1Transaction)
+	return lt
+}
+
+// RoochTransaction returns the L2 transaction, nil for other variants
+func (ltd *LedgerTxData) RoochTransaction() *RoochTransaction {
+	rt, _ := ltd.TxData.(*RoochTransaction)
+	return rt
+}
 
 func (ltd *LedgerTxData) MarshalBCS(ser *bcs.Serializer) {
 	if ltd == nil || ltd.TxData == nil {
@@ -272,6 +449,33 @@ func (ltd *LedgerTxData) MarshalBCS(ser *bcs.Serializer) {
 }
 func (ltd *LedgerTxData) UnmarshalBCS(des *bcs.Deserializer) {
 	txDataType := LedgerTxDataVariant(des.Uleb128())
+	if err := ltd.setVariant(txDataType); err != nil {
+		des.SetError(err)
+		return
+	}
+	ltd.TxData.UnmarshalBCS(des)
+}
+
+// UnmarshalJSON converts the LedgerTxData from the LedgerTxDataView of the RPC, tagged by its type field
+func (ltd *LedgerTxData) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		Type string `json:"type"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	txDataType, ok := ledgerTxDataTypes[data.Type]
+	if !ok {
+		return fmt.Errorf("Invalid ledger tx data type, %s", data.Type)
+	}
+	if err := ltd.setVariant(txDataType); err != nil {
+		return err
+	}
+	return json.Unmarshal(b, ltd.TxData)
+}
+
+func (ltd *LedgerTxData) setVariant(txDataType LedgerTxDataVariant) error {
 	switch txDataType {
 	case LedgerTxDataVariantL1Block:
 		ltd.TxData = &L1Block{}
@@ -280,30 +484,30 @@ func (ltd *LedgerTxData) UnmarshalBCS(des *bcs.Deserializer) {
 	case LedgerTxDataVariantL2Tx:
 		ltd.TxData = &RoochTransaction{}
 	default:
-		des.SetError(fmt.Errorf("Invalid ledger tx data type, %d", txDataType))
-		return
+		return fmt.Errorf("Invalid ledger tx data type, %d", txDataType)
 	}
-
-	ltd.TxData.UnmarshalBCS(des)
+	return nil
 }
 
-//type LedgerTxData struct {
-//	data          LedgerTxData            `json:"data"`
-//	sequence_info TransactionSequenceInfo `json:"sequence_info"`
-//}
-
-//endregion
-//endregion
-
 //#[derive(Clone, Debug, Eq, PartialEq, Serialize, Deserialize)]
 //pub struct LedgerTransaction {
 //pub data: LedgerTxData,
 //pub sequence_info: TransactionSequenceInfo,
 //}
 
+// LedgerTransaction is a transaction with its position in the ledger
 type LedgerTransaction struct {
-	data          LedgerTxData            `json:"data"`
-	sequence_info TransactionSequenceInfo `json:"sequence_info"`
+	Data         LedgerTxData            `json:"data"`
+	SequenceInfo TransactionSequenceInfo `json:"sequence_info"`
+}
+
+func (lt *LedgerTransaction) MarshalBCS(ser *bcs.Serializer) {
+	lt.Data.MarshalBCS(ser)
+	lt.SequenceInfo.MarshalBCS(ser)
+}
+func (lt *LedgerTransaction) UnmarshalBCS(des *bcs.Deserializer) {
+	lt.Data.UnmarshalBCS(des)
+	lt.SequenceInfo.UnmarshalBCS(des)
 }
 
 //#[derive(Debug, Clone)]
@@ -312,14 +516,172 @@ type LedgerTransaction struct {
 //pub execution_info: Option<TransactionExecutionInfo>,
 //}
 
+// TransactionWithInfo is a ledger transaction with its execution info, ExecutionInfo is nil for
+// transactions that are not executed yet
 type TransactionWithInfo struct {
-	Transaction    LedgerTransaction        `json:"transaction"`
-	execution_info TransactionExecutionInfo `json:"execution_info,omitempty"`
+	Transaction   LedgerTransaction         `json:"transaction"`
+	ExecutionInfo *TransactionExecutionInfo `json:"execution_info,omitempty"`
 }
 
-//type AccumulatorInfo struct {
-//	AccumulatorRoot    string   `json:"accumulator_root"`
-//	FrozenSubtreeRoots []string `json:"frozen_subtree_roots"`
-//	NumLeaves          string   `json:"num_leaves"`
-//	NumNodes           string   `json:"num_nodes"`
-//}
+func (ti *TransactionWithInfo) MarshalBCS(ser *bcs.Serializer) {
+	ti.Transaction.MarshalBCS(ser)
+	if ti.ExecutionInfo == nil {
+		ser.Bool(false)
+		return
+	}
+	ser.Bool(true)
+	ti.ExecutionInfo.MarshalBCS(ser)
+}
+func (ti *TransactionWithInfo) UnmarshalBCS(des *bcs.Deserializer) {
+	ti.Transaction.UnmarshalBCS(des)
+	ti.ExecutionInfo = nil
+	if des.Bool() {
+		ti.ExecutionInfo = &TransactionExecutionInfo{}
+		ti.ExecutionInfo.UnmarshalBCS(des)
+	}
+}
+
+// VerifyTxHash recomputes the hash of the transaction and checks it against the hash of the execution info,
+// the hash is returned for further checks e.g. of the tx order signature
+func (ti *TransactionWithInfo) VerifyTxHash() (H256, error) {
+	txHash, err := ti.Transaction.Data.TxHash()
+	if err != nil {
+		return H256{}, err
+	}
+	if ti.ExecutionInfo != nil && ti.ExecutionInfo.TxHash != txHash {
+		return H256{}, fmt.Errorf("%w: computed %s, execution info has %s", ErrTxHashMismatch, txHash, ti.ExecutionInfo.TxHash)
+	}
+	return txHash, nil
+}
+
+// Verify checks the transaction without trusting the node that returned it: the tx hash is recomputed
+// and its tx order must be signed by the sequencer
+func (ti *TransactionWithInfo) Verify(sequencer crypto.PublicKey) error {
+	txHash, err := ti.VerifyTxHash()
+	if err != nil {
+		return err
+	}
+	return ti.Transaction.SequenceInfo.VerifyTxOrderSignature(txHash, sequencer)
+}
+
+// VerifyInclusion checks the transaction is in the tx accumulator with the trusted root at its tx order
+func (ti *TransactionWithInfo) VerifyInclusion(trustedRoot H256, proof *AccumulatorProof) error {
+	txHash, err := ti.VerifyTxHash()
+	if err != nil {
+		return err
+	}
+	return proof.Verify(trustedRoot, txHash, ti.Transaction.SequenceInfo.TxOrder)
+}
+
+// VerifyInclusionIn checks the transaction is in the trusted tx accumulator at its tx order, unlike
+// [TransactionWithInfo.VerifyInclusion] the tx order is also checked against the number of leaves
+func (ti *TransactionWithInfo) VerifyInclusionIn(trusted *AccumulatorInfo, proof *AccumulatorProof) error {
+	txHash, err := ti.VerifyTxHash()
+	if err != nil {
+		return err
+	}
+	return trusted.VerifyProof(proof, txHash, ti.Transaction.SequenceInfo.TxOrder)
+}
+
+// TransactionEvent is an event emitted by a transaction, EventData is the BCS of the event
+type TransactionEvent struct {
+	EventHandleID ObjectID `json:"event_handle_id"`
+	EventSeq      uint64   `json:"event_seq"`
+	EventType     string   `json:"event_type"`
+	EventData     []byte   `json:"event_data"`
+	EventIndex    uint64   `json:"event_index"`
+}
+
+// UnmarshalJSON converts the TransactionEvent from the TransactionEventView of the RPC
+func (te *TransactionEvent) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		EventID struct {
+			EventHandleID ObjectID `json:"event_handle_id"`
+			EventSeq      strU64   `json:"event_seq"`
+		} `json:"event_id"`
+		EventType  string   `json:"event_type"`
+		EventData  hexBytes `json:"event_data"`
+		EventIndex strU64   `json:"event_index"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	te.EventHandleID = data.EventID.EventHandleID
+	te.EventSeq = uint64(data.EventID.EventSeq)
+	te.EventType = data.EventType
+	te.EventData = data.EventData
+	te.EventIndex = uint64(data.EventIndex)
+	return nil
+}
+
+// TransactionOutput is the output of an executed transaction, the state change set is kept as
+// the raw JSON of the RPC view
+type TransactionOutput struct {
+	Status    KeptVMStatus       `json:"status"`
+	Events    []TransactionEvent `json:"events"`
+	GasUsed   uint64             `json:"gas_used"`
+	IsUpgrade bool               `json:"is_upgrade"`
+	Changeset json.RawMessage    `json:"changeset"`
+}
+
+// UnmarshalJSON converts the TransactionOutput from the TransactionOutputView of the RPC
+func (to *TransactionOutput) UnmarshalJSON(b []byte) error {
+	type inner struct {
+		Status    KeptVMStatus       `json:"status"`
+		Events    []TransactionEvent `json:"events"`
+		GasUsed   strU64             `json:"gas_used"`
+		IsUpgrade bool               `json:"is_upgrade"`
+		Changeset json.RawMessage    `json:"changeset"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return err
+	}
+	to.Status = data.Status
+	to.Events = data.Events
+	to.GasUsed = uint64(data.GasUsed)
+	to.IsUpgrade = data.IsUpgrade
+	to.Changeset = data.Changeset
+	return nil
+}
+
+func sha3BCS(value bcs.Marshaler) (H256, error) {
+	encoded, err := bcs.Serialize(value)
+	if err != nil {
+		return H256{}, err
+	}
+	return NewH256(utils.Sha3256(encoded))
+}
+
+// strU64 is a u64 encoded as a decimal string in the RPC views
+type strU64 uint64
+
+func (u *strU64) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	value, err := utils.StrToUint64(str)
+	if err != nil {
+		return fmt.Errorf("invalid u64 %s: %w", str, err)
+	}
+	*u = strU64(value)
+	return nil
+}
+
+// hexBytes is a byte vector encoded as a 0x prefixed hex string in the RPC views
+type hexBytes []byte
+
+func (h *hexBytes) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	bytes, err := utils.ParseHex(str)
+	if err != nil {
+		return fmt.Errorf("invalid hex %s: %w", str, err)
+	}
+	*h = bytes
+	return nil
+}
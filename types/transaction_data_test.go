@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+// Golden vectors laid out field by field as the Rust rooch_types::TransactionData is BCS encoded
+var (
+	goldenSender     = "0000000000000000000000000000000000000000000000000000000000000042"
+	goldenFramework  = "0000000000000000000000000000000000000000000000000000000000000003"
+	goldenHeader     = goldenSender + "0100000000000000" + "0400000000000000" + "80f0fa0200000000"
+	goldenEmptyCall  = goldenHeader + "01" + goldenFramework + "05" + hex.EncodeToString([]byte("empty")) + "05" + hex.EncodeToString([]byte("empty")) + "00" + "00"
+	goldenGenericTag = "07" + goldenFramework + "08" + hex.EncodeToString([]byte("gas_coin")) + "04" + hex.EncodeToString([]byte("RGas")) + "00"
+	goldenTransfer   = goldenHeader + "01" + goldenFramework + "08" + hex.EncodeToString([]byte("transfer")) + "0d" + hex.EncodeToString([]byte("transfer_coin")) +
+		"01" + goldenGenericTag +
+		"02" + "20" + goldenSender + "20" + "64" + strings.Repeat("00", 31)
+	goldenScript       = goldenHeader + "00" + "04a11ceb0b" + "01" + "02" + "01" + "0105"
+	goldenModuleBundle = goldenHeader + "02" + "02" + "04a11ceb0b" + "02a11c"
+)
+
+func goldenTransactionData(action MoveActionImpl) *TransactionData {
+	sender, _ := address.NewRoochAddress("0x42")
+	return &TransactionData{
+		Sender:         *sender,
+		SequenceNumber: 1,
+		ChainId:        4,
+		MaxGasAmount:   50000000,
+		Action:         MoveAction{Action: action},
+	}
+}
+
+func TestTransactionDataEncoding(t *testing.T) {
+	framework, _ := address.NewRoochAddress("0x3")
+	recipient, _ := address.NewRoochAddress("0x42")
+	amount := append([]byte{0x64}, make([]byte, 31)...)
+
+	tests := []struct {
+		name   string
+		action MoveActionImpl
+		golden string
+	}{
+		{
+			name: "function call",
+			action: &FunctionCall{
+				FunctionId: FunctionId{ModuleId: ModuleId{Address: *framework, Name: "empty"}, FunctionName: "empty"},
+				TypeArgs:   []TypeTag{},
+				Args:       [][]byte{},
+			},
+			golden: goldenEmptyCall,
+		},
+		{
+			name: "generic function call",
+			action: &FunctionCall{
+				FunctionId: FunctionId{ModuleId: ModuleId{Address: *framework, Name: "transfer"}, FunctionName: "transfer_coin"},
+				TypeArgs:   []TypeTag{{&StructTag{Address: *framework, Module: "gas_coin", Name: "RGas"}}},
+				Args:       [][]byte{recipient.Bytes(), amount},
+			},
+			golden: goldenTransfer,
+		},
+		{
+			name: "script call",
+			action: &ScriptCall{
+				Code:   []byte{0xa1, 0x1c, 0xeb, 0x0b},
+				TyArgs: []TypeTag{{&U64Tag{}}},
+				Args:   [][]byte{{0x05}},
+			},
+			golden: goldenScript,
+		},
+		{
+			name:   "module bundle",
+			action: &ModuleBundle{Value: [][]byte{{0xa1, 0x1c, 0xeb, 0x0b}, {0xa1, 0x1c}}},
+			golden: goldenModuleBundle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := goldenTransactionData(tt.action)
+			encoded, err := bcs.Serialize(data)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.golden, hex.EncodeToString(encoded))
+
+			decoded := &TransactionData{}
+			assert.NoError(t, bcs.Deserialize(decoded, encoded))
+			reencoded, err := bcs.Serialize(decoded)
+			assert.NoError(t, err)
+			assert.Equal(t, encoded, reencoded)
+
+			hash, err := data.Hash()
+			assert.NoError(t, err)
+			assert.Len(t, hash, 32)
+		})
+	}
+}
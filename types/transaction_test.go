@@ -0,0 +1,295 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/crypto"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testH256(b byte) H256 {
+	var h H256
+	for i := range h {
+		h[i] = b
+	}
+	return h
+}
+
+func testSequenceInfo() TransactionSequenceInfo {
+	return TransactionSequenceInfo{
+		TxOrder:                         7,
+		TxOrderSignature:                []byte{0x01, 0x02, 0x03},
+		TxAccumulatorRoot:               testH256(0xaa),
+		TxTimestamp:                     1719965096135,
+		TxAccumulatorFrozenSubtreeRoots: []H256{testH256(0xbb)},
+		TxAccumulatorNumLeaves:          8,
+		TxAccumulatorNumNodes:           15,
+	}
+}
+
+func testRoochTransaction() *RoochTransaction {
+	return &RoochTransaction{
+		Data:          *goldenTransactionData(&ModuleBundle{Value: [][]byte{{0xa1, 0x1c, 0xeb, 0x0b}, {0xa1, 0x1c}}}),
+		Authenticator: crypto.Authenticator{AuthValidatorId: 1, Payload: []byte{0x00, 0x11}},
+	}
+}
+
+func TestTransactionWithInfoBCS(t *testing.T) {
+	executionInfo := &TransactionExecutionInfo{
+		TxHash:    testH256(0x01),
+		StateRoot: testH256(0x02),
+		Size:      100,
+		EventRoot: testH256(0x03),
+		GasUsed:   2000,
+		Status:    KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: AbortLocation{Module: &ModuleId{Address: AddressThree, Name: "coin"}}, AbortCode: 65537},
+	}
+	txData := []LedgerTxDataImpl{
+		&L1Block{ChainId: 1, BlockHeight: 840000, BlockHash: []byte{0xde, 0xad}},
+		&L1Transaction{ChainId: 1, BlockHash: []byte{0xde, 0xad}, TxID: []byte{0xbe, 0xef}},
+		testRoochTransaction(),
+	}
+	for _, data := range txData {
+		for _, info := range []*TransactionExecutionInfo{nil, executionInfo} {
+			tx := &TransactionWithInfo{
+				Transaction:   LedgerTransaction{Data: LedgerTxData{TxData: data}, SequenceInfo: testSequenceInfo()},
+				ExecutionInfo: info,
+			}
+			encoded, err := bcs.Serialize(tx)
+			assert.NoError(t, err)
+			assert.Equal(t, byte(data.LedgerTxDataType()), encoded[0])
+
+			decoded := &TransactionWithInfo{}
+			assert.NoError(t, bcs.Deserialize(decoded, encoded))
+			assert.Equal(t, tx, decoded)
+		}
+	}
+}
+
+func TestRoochTransactionBCS(t *testing.T) {
+	// The data hash of the Rust RoochTransaction is not serialized
+	encoded, err := bcs.Serialize(testRoochTransaction())
+	assert.NoError(t, err)
+	assert.Equal(t, goldenModuleBundle+"0100000000000000"+"020011", hex.EncodeToString(encoded))
+}
+
+func TestTransactionExecutionInfoBCS(t *testing.T) {
+	info := &TransactionExecutionInfo{
+		TxHash:    testH256(0x01),
+		StateRoot: testH256(0x02),
+		Size:      1,
+		EventRoot: testH256(0x03),
+		GasUsed:   2,
+		Status:    KeptVMStatus{Type: KeptVMStatusExecuted},
+	}
+	encoded, err := bcs.Serialize(info)
+	assert.NoError(t, err)
+	expected := "20" + strings.Repeat("01", 32) + "20" + strings.Repeat("02", 32) + "0100000000000000" +
+		"20" + strings.Repeat("03", 32) + "0200000000000000" + "00"
+	assert.Equal(t, expected, hex.EncodeToString(encoded))
+}
+
+func TestTransactionWithInfoJSON(t *testing.T) {
+	raw, err := bcs.Serialize(testRoochTransaction())
+	assert.NoError(t, err)
+
+	testJson := `{
+  "transaction": {
+    "data": {
+      "type": "l2_tx",
+      "sender": "0x0000000000000000000000000000000000000000000000000000000000000042",
+      "sequence_number": "1",
+      "action_type": "module_bundle",
+      "raw": "0x` + hex.EncodeToString(raw) + `"
+    },
+    "sequence_info": {
+      "tx_order": "7",
+      "tx_order_signature": "0x010203",
+      "tx_accumulator_root": "0x` + strings.Repeat("aa", 32) + `",
+      "tx_timestamp": "1719965096135",
+      "tx_accumulator_frozen_subtree_roots": ["0x` + strings.Repeat("bb", 32) + `"],
+      "tx_accumulator_num_leaves": "8",
+      "tx_accumulator_num_nodes": "15"
+    }
+  },
+  "execution_info": {
+    "tx_hash": "0x` + strings.Repeat("01", 32) + `",
+    "state_root": "0x` + strings.Repeat("02", 32) + `",
+    "event_root": "0x` + strings.Repeat("03", 32) + `",
+    "size": "100",
+    "gas_used": "2000",
+    "status": {
+      "type": "moveabort",
+      "location": "0x3::coin",
+      "abort_code": "65537"
+    }
+  }
+}`
+	tx := &TransactionWithInfo{}
+	assert.NoError(t, json.Unmarshal([]byte(testJson), tx))
+	assert.Equal(t, testSequenceInfo(), tx.Transaction.SequenceInfo)
+	assert.Equal(t, testRoochTransaction(), tx.Transaction.Data.RoochTransaction())
+	assert.Nil(t, tx.Transaction.Data.L1Block())
+
+	info := tx.ExecutionInfo
+	assert.NotNil(t, info)
+	assert.Equal(t, testH256(0x01), info.TxHash)
+	assert.Equal(t, uint64(100), info.Size)
+	assert.Equal(t, uint64(2000), info.GasUsed)
+	assert.Equal(t, KeptVMStatusMoveAbort, info.Status.Type)
+	assert.Equal(t, "0x3::coin", info.Status.Location.String())
+	assert.Equal(t, uint64(65537), info.Status.AbortCode)
+
+	t.Run("l1 variants", func(t *testing.T) {
+		data := &LedgerTxData{}
+		assert.NoError(t, json.Unmarshal([]byte(`{"type":"l1_block","chain_id":"1","block_height":"840000","block_hash":"0xdead","bitcoin_block_hash":"adde"}`), data))
+		assert.Equal(t, &L1Block{ChainId: 1, BlockHeight: 840000, BlockHash: []byte{0xde, 0xad}}, data.L1Block())
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"type":"l1_tx","chain_id":"1","block_hash":"0xdead","txid":"0xbeef"}`), data))
+		assert.Equal(t, &L1Transaction{ChainId: 1, BlockHash: []byte{0xde, 0xad}, TxID: []byte{0xbe, 0xef}}, data.L1Transaction())
+
+		assert.Error(t, json.Unmarshal([]byte(`{"type":"l3_tx"}`), data))
+	})
+
+	t.Run("pending transaction", func(t *testing.T) {
+		pending := &TransactionWithInfo{}
+		input := strings.Replace(testJson, `"execution_info": {`, `"execution_info": null, "unused": {`, 1)
+		assert.NoError(t, json.Unmarshal([]byte(input), pending))
+		assert.Nil(t, pending.ExecutionInfo)
+	})
+}
+
+func TestTransactionOutputJSON(t *testing.T) {
+	testJson := `{
+  "status": {"type": "executionfailure", "location": "0x2::object", "function": 3, "code_offset": 12},
+  "changeset": {"global_size": "10", "state_root": "0x00", "changes": []},
+  "events": [{
+    "event_id": {"event_handle_id": "0x` + strings.Repeat("0c", 32) + `", "event_seq": "4"},
+    "event_type": "0x3::gas_coin::RGas",
+    "event_data": "0x0102",
+    "event_index": "0"
+  }],
+  "gas_used": "1234",
+  "is_upgrade": false
+}`
+	output := &TransactionOutput{}
+	assert.NoError(t, json.Unmarshal([]byte(testJson), output))
+	assert.Equal(t, KeptVMStatusExecutionFailure, output.Status.Type)
+	assert.Equal(t, uint16(3), output.Status.Function)
+	assert.Equal(t, uint16(12), output.Status.CodeOffset)
+	assert.Equal(t, uint64(1234), output.GasUsed)
+	assert.Len(t, output.Events, 1)
+	assert.Equal(t, uint64(4), output.Events[0].EventSeq)
+	assert.Equal(t, []byte{0x01, 0x02}, output.Events[0].EventData)
+	assert.Contains(t, string(output.Changeset), "global_size")
+}
+
+// testSequencerKey is a minimal ed25519 crypto.PublicKey, the keypairs packages depend on types
+type testSequencerKey struct {
+	key ed25519.PublicKey
+}
+
+func (k *testSequencerKey) Equals(other crypto.PublicKey) bool {
+	return bytes.Equal(k.key, other.ToBytes())
+}
+func (k *testSequencerKey) ToBase64() string { return base64.StdEncoding.EncodeToString(k.key) }
+func (k *testSequencerKey) String() string   { return k.ToBase64() }
+func (k *testSequencerKey) ToBytes() []byte  { return k.key }
+func (k *testSequencerKey) Flag() uint8      { return uint8(crypto.Ed25519Flag) }
+func (k *testSequencerKey) ToRoochAddress() (*address.RoochAddress, error) {
+	return address.NewRoochAddressFromBytes(k.key)
+}
+func (k *testSequencerKey) Verify(data []byte, signature []byte) (bool, error) {
+	return ed25519.Verify(k.key, data, signature), nil
+}
+
+func signTxOrder(secretKey ed25519.PrivateKey, txHash H256, txOrder uint64) []byte {
+	witnessHash := TxOrderWitnessHash(txHash, txOrder)
+	signature := append([]byte{byte(crypto.Ed25519Flag)}, ed25519.Sign(secretKey, witnessHash.Bytes())...)
+	return append(signature, secretKey.Public().(ed25519.PublicKey)...)
+}
+
+func TestTransactionTxHash(t *testing.T) {
+	rt := testRoochTransaction()
+	dataHash, err := rt.Data.Hash()
+	assert.NoError(t, err)
+	txHash, err := rt.TxHash()
+	assert.NoError(t, err)
+	assert.Equal(t, dataHash, txHash.Bytes())
+
+	block := &L1Block{ChainId: 1, BlockHeight: 840000, BlockHash: []byte{0xde, 0xad}}
+	encoded, err := bcs.Serialize(block)
+	assert.NoError(t, err)
+	blockHash, err := (&LedgerTxData{TxData: block}).TxHash()
+	assert.NoError(t, err)
+	assert.Equal(t, utils.Sha3256(encoded), blockHash.Bytes())
+
+	tx := &TransactionWithInfo{
+		Transaction:   LedgerTransaction{Data: LedgerTxData{TxData: rt}, SequenceInfo: testSequenceInfo()},
+		ExecutionInfo: &TransactionExecutionInfo{TxHash: txHash},
+	}
+	verified, err := tx.VerifyTxHash()
+	assert.NoError(t, err)
+	assert.Equal(t, txHash, verified)
+
+	tx.ExecutionInfo.TxHash = testH256(0x01)
+	_, err = tx.VerifyTxHash()
+	assert.ErrorIs(t, err, ErrTxHashMismatch)
+}
+
+func TestTransactionVerify(t *testing.T) {
+	publicKey, secretKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	sequencer := &testSequencerKey{key: publicKey}
+
+	rt := testRoochTransaction()
+	txHash, err := rt.TxHash()
+	assert.NoError(t, err)
+	sequenceInfo := testSequenceInfo()
+	sequenceInfo.TxOrder = 2
+	sequenceInfo.TxOrderSignature = signTxOrder(secretKey, txHash, sequenceInfo.TxOrder)
+	tx := &TransactionWithInfo{
+		Transaction:   LedgerTransaction{Data: LedgerTxData{TxData: rt}, SequenceInfo: sequenceInfo},
+		ExecutionInfo: &TransactionExecutionInfo{TxHash: txHash},
+	}
+	assert.NoError(t, tx.Verify(sequencer))
+
+	t.Run("wrong tx order", func(t *testing.T) {
+		reordered := *tx
+		reordered.Transaction.SequenceInfo.TxOrder = 3
+		assert.ErrorIs(t, reordered.Verify(sequencer), ErrInvalidTxOrderSignature)
+	})
+
+	t.Run("other sequencer", func(t *testing.T) {
+		otherPublicKey, _, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, tx.Verify(&testSequencerKey{key: otherPublicKey}), ErrInvalidTxOrderSignature)
+	})
+
+	t.Run("truncated signature", func(t *testing.T) {
+		truncated := *tx
+		truncated.Transaction.SequenceInfo.TxOrderSignature = sequenceInfo.TxOrderSignature[:32]
+		assert.ErrorIs(t, truncated.Verify(sequencer), ErrInvalidTxOrderSignature)
+	})
+
+	t.Run("inclusion", func(t *testing.T) {
+		leaves, size, info := testAccumulator(5)
+		leaves[2] = txHash
+		info.AccumulatorRoot = testAccumulatorRoot(leaves, size)
+		proof := &AccumulatorProof{Siblings: testAccumulatorProof(leaves, size, 2)}
+		assert.NoError(t, tx.VerifyInclusion(info.AccumulatorRoot, proof))
+		assert.ErrorIs(t, tx.VerifyInclusion(testH256(0x01), proof), ErrInvalidAccumulatorProof)
+
+		assert.NoError(t, tx.VerifyInclusionIn(&info, proof))
+		info.NumLeaves = 2
+		assert.ErrorIs(t, tx.VerifyInclusionIn(&info, proof), ErrInvalidAccumulatorProof)
+	})
+}
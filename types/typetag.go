@@ -24,6 +24,10 @@ const (
 	TypeTagU16     TypeTagVariant = 8  // Represents the u16 type in Move U16Tag
 	TypeTagU32     TypeTagVariant = 9  // Represents the u32 type in Move U32Tag
 	TypeTagU256    TypeTagVariant = 10 // Represents the u256 type in Move U256Tag
+
+	// TypeTagGeneric and TypeTagReference only appear in function ABIs and can't be BCS encoded
+	TypeTagGeneric   TypeTagVariant = 254 // Represents a generic type parameter T0 in Move GenericTag
+	TypeTagReference TypeTagVariant = 255 // Represents the &T and &mut T types in Move ReferenceTag
 )
 
 // TypeTagImpl is an interface describing all the different types of [TypeTag].  Unfortunately because of how serialization
@@ -398,8 +402,8 @@ func (xt *StructTag) String() string {
 
 }
 
-// String outputs to the form address::module::name<type1, type2> e.g.
-// 0x1::string::String or 0x42::my_mod::MultiType<u8,0x1::string::String>
+// ToCanonicalString outputs to the form address::module::name<type1,type2> with every address in
+// long form e.g. 0x0000000000000000000000000000000000000000000000000000000000000001::string::String
 func (xt *StructTag) ToCanonicalString() string {
 	out := strings.Builder{}
 	out.WriteString(xt.Address.StringLong())
@@ -413,14 +417,29 @@ func (xt *StructTag) ToCanonicalString() string {
 			if i != 0 {
 				out.WriteRune(',')
 			}
-			typetag_type := tp.Value.GetType()
-			if typetag_type == TypeTagStruct {
-				// Convert should not fail
-				structTag, _ := tp.Value.(*StructTag)
-				out.WriteString(structTag.ToCanonicalString())
-			} else {
-				out.WriteString(tp.String())
+			out.WriteString(TypeTagToCanonicalString(&tp))
+		}
+		out.WriteRune('>')
+	}
+	return out.String()
+}
+
+// ToShortString outputs to the form address::module::name<type1,type2> with every address in
+// short form e.g. 0x3::coin_store::CoinStore<0x3::gas_coin::RGas>
+func (xt *StructTag) ToShortString() string {
+	out := strings.Builder{}
+	out.WriteString(xt.Address.StringShort())
+	out.WriteString("::")
+	out.WriteString(xt.Module)
+	out.WriteString("::")
+	out.WriteString(xt.Name)
+	if len(xt.TypeParams) != 0 {
+		out.WriteRune('<')
+		for i, tp := range xt.TypeParams {
+			if i != 0 {
+				out.WriteRune(',')
 			}
+			out.WriteString(TypeTagToShortString(&tp))
 		}
 		out.WriteRune('>')
 	}
@@ -471,6 +490,72 @@ func (xt *StructTag) UnmarshalBCS(des *bcs.Deserializer) {
 //endregion
 //endregion
 
+//region GenericTag
+
+// GenericTag represents the generic type parameter T{Index} of a function or struct ABI
+type GenericTag struct {
+	Index uint16
+}
+
+//region GenericTag TypeTagImpl
+
+func (xt *GenericTag) String() string {
+	return fmt.Sprintf("T%d", xt.Index)
+}
+
+func (xt *GenericTag) GetType() TypeTagVariant {
+	return TypeTagGeneric
+}
+
+//endregion
+
+//region GenericTag bcs.Struct
+
+func (xt *GenericTag) MarshalBCS(ser *bcs.Serializer) {
+	ser.SetError(fmt.Errorf("generic type parameter %s can't be serialized", xt.String()))
+}
+func (xt *GenericTag) UnmarshalBCS(des *bcs.Deserializer) {
+	des.SetError(fmt.Errorf("generic type parameter can't be deserialized"))
+}
+
+//endregion
+//endregion
+
+//region ReferenceTag
+
+// ReferenceTag represents the &T or &mut T type of a function parameter, where T is another [TypeTag]
+type ReferenceTag struct {
+	Mutable   bool
+	TypeParam TypeTag
+}
+
+//region ReferenceTag TypeTagImpl
+
+func (xt *ReferenceTag) String() string {
+	if xt.Mutable {
+		return "&mut " + xt.TypeParam.String()
+	}
+	return "&" + xt.TypeParam.String()
+}
+
+func (xt *ReferenceTag) GetType() TypeTagVariant {
+	return TypeTagReference
+}
+
+//endregion
+
+//region ReferenceTag bcs.Struct
+
+func (xt *ReferenceTag) MarshalBCS(ser *bcs.Serializer) {
+	ser.SetError(fmt.Errorf("reference type %s can't be serialized", xt.String()))
+}
+func (xt *ReferenceTag) UnmarshalBCS(des *bcs.Deserializer) {
+	des.SetError(fmt.Errorf("reference type can't be deserialized"))
+}
+
+//endregion
+//endregion
+
 //region TypeTag helpers
 
 // NewTypeTag wraps a TypeTagImpl in a TypeTag
@@ -490,7 +575,7 @@ func NewVectorTag(inner TypeTagImpl) *VectorTag {
 // NewStringTag creates a TypeTag for 0x1::string::String
 func NewStringTag() *StructTag {
 	return &StructTag{
-		Address:    AddressTwo,
+		Address:    AddressOne,
 		Module:     "string",
 		Name:       "String",
 		TypeParams: []TypeTag{},
@@ -547,6 +632,41 @@ func TypeTagFromStructTag(st *StructTag) *TypeTag {
 	}
 }
 
+// TypeTagToCanonicalString outputs the type with every struct address in long form, this is
+// the form hashed in object ID and field key derivations
+func TypeTagToCanonicalString(tt *TypeTag) string {
+	switch v := tt.Value.(type) {
+	case *StructTag:
+		return v.ToCanonicalString()
+	case *VectorTag:
+		return fmt.Sprintf("vector<%s>", TypeTagToCanonicalString(&v.TypeParam))
+	case *ReferenceTag:
+		if v.Mutable {
+			return "&mut " + TypeTagToCanonicalString(&v.TypeParam)
+		}
+		return "&" + TypeTagToCanonicalString(&v.TypeParam)
+	default:
+		return tt.String()
+	}
+}
+
+// TypeTagToShortString outputs the type with every struct address in short form, without leading zeros
+func TypeTagToShortString(tt *TypeTag) string {
+	switch v := tt.Value.(type) {
+	case *StructTag:
+		return v.ToShortString()
+	case *VectorTag:
+		return fmt.Sprintf("vector<%s>", TypeTagToShortString(&v.TypeParam))
+	case *ReferenceTag:
+		if v.Mutable {
+			return "&mut " + TypeTagToShortString(&v.TypeParam)
+		}
+		return "&" + TypeTagToShortString(&v.TypeParam)
+	default:
+		return tt.String()
+	}
+}
+
 // structTagToObjectID converts a StructTag to an object ID
 func StructTagToObjectID(st *StructTag) (ObjectID, error) {
 	canonicalStr := st.ToCanonicalString()
@@ -0,0 +1,355 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rooch-network/rooch-go-sdk/address"
+)
+
+var ErrInvalidTypeTag = errors.New("invalid type tag")
+
+// NamedAddresses are the named addresses accepted in place of the address of a struct, e.g. std::string::String
+var NamedAddresses = map[string]RoochAddress{
+	"std":             AddressOne,
+	"moveos_std":      AddressTwo,
+	"rooch_framework": AddressThree,
+	"bitcoin_move":    AddressFour,
+}
+
+// ParseTypeTag parses a concrete Move type such as u64, vector<u8> or 0x3::coin_store::CoinStore<0x3::gas_coin::RGas>,
+// addresses can be short, long, bech32 or named. The result of [TypeTagToCanonicalString] parses to the same type
+func ParseTypeTag(input string) (*TypeTag, error) {
+	p, err := newTypeTagParser(input, false)
+	if err != nil {
+		return nil, err
+	}
+	return p.parseAll()
+}
+
+// ParseAbiTypeTag parses the type of a function ABI parameter, which may also be a &T or &mut T
+// reference, or contain T0 style generic type parameters
+func ParseAbiTypeTag(input string) (*TypeTag, error) {
+	p, err := newTypeTagParser(input, true)
+	if err != nil {
+		return nil, err
+	}
+	return p.parseAll()
+}
+
+// ParseStructTag parses a Move struct type such as 0x1::string::String
+func ParseStructTag(input string) (*StructTag, error) {
+	tt, err := ParseTypeTag(input)
+	if err != nil {
+		return nil, err
+	}
+	st, ok := tt.Value.(*StructTag)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not a struct", ErrInvalidTypeTag, input)
+	}
+	return st, nil
+}
+
+// ParseTypeTags parses a comma separated list of concrete Move types, e.g. the type arguments of a call
+func ParseTypeTags(input string) ([]TypeTag, error) {
+	p, err := newTypeTagParser(input, false)
+	if err != nil {
+		return nil, err
+	}
+	tags := []TypeTag{}
+	if p.peek().kind == tokenEOF {
+		return tags, nil
+	}
+	for {
+		tag, err := p.parseType(true)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, *tag)
+		if p.peek().kind != tokenComma {
+			break
+		}
+		p.next()
+	}
+	if err := p.expect(tokenEOF); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenColons
+	tokenLess
+	tokenGreater
+	tokenComma
+	tokenAmpersand
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of input"
+	case tokenIdent:
+		return "identifier"
+	case tokenColons:
+		return "'::'"
+	case tokenLess:
+		return "'<'"
+	case tokenGreater:
+		return "'>'"
+	case tokenComma:
+		return "','"
+	default:
+		return "'&'"
+	}
+}
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenIdent {
+		return fmt.Sprintf("%q", t.value)
+	}
+	return t.kind.String()
+}
+
+// tokenizeTypeTag splits the input into tokens, whitespace is only a separator
+func tokenizeTypeTag(input string) ([]token, error) {
+	var tokens []token
+	for pos := 0; pos < len(input); {
+		c := input[pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pos++
+		case c == ':':
+			if pos+1 >= len(input) || input[pos+1] != ':' {
+				return nil, fmt.Errorf("%w: expected '::' at position %d", ErrInvalidTypeTag, pos)
+			}
+			tokens = append(tokens, token{kind: tokenColons, value: "::", pos: pos})
+			pos += 2
+		case c == '<':
+			tokens = append(tokens, token{kind: tokenLess, value: "<", pos: pos})
+			pos++
+		case c == '>':
+			tokens = append(tokens, token{kind: tokenGreater, value: ">", pos: pos})
+			pos++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, value: ",", pos: pos})
+			pos++
+		case c == '&':
+			tokens = append(tokens, token{kind: tokenAmpersand, value: "&", pos: pos})
+			pos++
+		case isIdentChar(c):
+			start := pos
+			for pos < len(input) && isIdentChar(input[pos]) {
+				pos++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, value: input[start:pos], pos: start})
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q at position %d", ErrInvalidTypeTag, c, pos)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(input)}), nil
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifier(s string) bool {
+	return len(s) > 0 && (s[0] < '0' || s[0] > '9')
+}
+
+type typeTagParser struct {
+	tokens []token
+	pos    int
+	abi    bool
+}
+
+func newTypeTagParser(input string, abi bool) (*typeTagParser, error) {
+	tokens, err := tokenizeTypeTag(input)
+	if err != nil {
+		return nil, err
+	}
+	return &typeTagParser{tokens: tokens, abi: abi}, nil
+}
+
+func (p *typeTagParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *typeTagParser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *typeTagParser) expect(kind tokenKind) error {
+	tok := p.next()
+	if tok.kind != kind {
+		return p.unexpected(tok, kind.String())
+	}
+	return nil
+}
+
+func (p *typeTagParser) unexpected(tok token, expected string) error {
+	return fmt.Errorf("%w: expected %s but found %s at position %d", ErrInvalidTypeTag, expected, tok, tok.pos)
+}
+
+func (p *typeTagParser) parseAll() (*TypeTag, error) {
+	tag, err := p.parseType(true)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(tokenEOF); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// parseType parses a type, references are only accepted at the top level of an ABI type
+func (p *typeTagParser) parseType(topLevel bool) (*TypeTag, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenAmpersand:
+		if !p.abi || !topLevel {
+			return nil, fmt.Errorf("%w: unexpected reference at position %d", ErrInvalidTypeTag, tok.pos)
+		}
+		mutable := false
+		if next := p.peek(); next.kind == tokenIdent && next.value == "mut" {
+			p.next()
+			mutable = true
+		}
+		inner, err := p.parseType(false)
+		if err != nil {
+			return nil, err
+		}
+		return &TypeTag{&ReferenceTag{Mutable: mutable, TypeParam: *inner}}, nil
+	case tokenIdent:
+	default:
+		return nil, p.unexpected(tok, "a type")
+	}
+
+	if p.peek().kind != tokenColons {
+		return p.parseNonStruct(tok)
+	}
+	return p.parseStruct(tok)
+}
+
+func (p *typeTagParser) parseNonStruct(tok token) (*TypeTag, error) {
+	switch tok.value {
+	case "bool":
+		return &TypeTag{&BoolTag{}}, nil
+	case "u8":
+		return &TypeTag{&U8Tag{}}, nil
+	case "u16":
+		return &TypeTag{&U16Tag{}}, nil
+	case "u32":
+		return &TypeTag{&U32Tag{}}, nil
+	case "u64":
+		return &TypeTag{&U64Tag{}}, nil
+	case "u128":
+		return &TypeTag{&U128Tag{}}, nil
+	case "u256":
+		return &TypeTag{&U256Tag{}}, nil
+	case "address":
+		return &TypeTag{&AddressTag{}}, nil
+	case "signer":
+		return &TypeTag{&SignerTag{}}, nil
+	case "vector":
+		if err := p.expect(tokenLess); err != nil {
+			return nil, err
+		}
+		inner, err := p.parseType(false)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenGreater); err != nil {
+			return nil, err
+		}
+		return &TypeTag{&VectorTag{TypeParam: *inner}}, nil
+	}
+
+	if p.abi && len(tok.value) > 1 && tok.value[0] == 'T' {
+		if index, err := strconv.ParseUint(tok.value[1:], 10, 16); err == nil {
+			return &TypeTag{&GenericTag{Index: uint16(index)}}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: unknown type %q at position %d", ErrInvalidTypeTag, tok.value, tok.pos)
+}
+
+func (p *typeTagParser) parseStruct(addressTok token) (*TypeTag, error) {
+	structAddress, err := parseStructAddress(addressTok)
+	if err != nil {
+		return nil, err
+	}
+
+	var names [2]string
+	for i := range names {
+		if err := p.expect(tokenColons); err != nil {
+			return nil, err
+		}
+		tok := p.next()
+		if tok.kind != tokenIdent || !isIdentifier(tok.value) {
+			return nil, p.unexpected(tok, "an identifier")
+		}
+		names[i] = tok.value
+	}
+
+	typeParams := []TypeTag{}
+	if p.peek().kind == tokenLess {
+		p.next()
+		for {
+			param, err := p.parseType(false)
+			if err != nil {
+				return nil, err
+			}
+			typeParams = append(typeParams, *param)
+			tok := p.next()
+			if tok.kind == tokenGreater {
+				break
+			}
+			if tok.kind != tokenComma {
+				return nil, p.unexpected(tok, "',' or '>'")
+			}
+			// A trailing comma is allowed before the closing bracket
+			if p.peek().kind == tokenGreater {
+				p.next()
+				break
+			}
+		}
+	}
+
+	return &TypeTag{&StructTag{
+		Address:    structAddress,
+		Module:     names[0],
+		Name:       names[1],
+		TypeParams: typeParams,
+	}}, nil
+}
+
+func parseStructAddress(tok token) (RoochAddress, error) {
+	if named, ok := NamedAddresses[tok.value]; ok {
+		return named, nil
+	}
+	if !strings.HasPrefix(tok.value, "0x") && !strings.HasPrefix(tok.value, address.RoochBech32Prefix+"1") {
+		return RoochAddress{}, fmt.Errorf("%w: invalid address %q at position %d", ErrInvalidTypeTag, tok.value, tok.pos)
+	}
+	parsed, err := address.ParseRoochAddress(tok.value)
+	if err != nil {
+		return RoochAddress{}, fmt.Errorf("%w: invalid address %q at position %d: %v", ErrInvalidTypeTag, tok.value, tok.pos, err)
+	}
+	return *parsed, nil
+}
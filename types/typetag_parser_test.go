@@ -0,0 +1,138 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTypeTag(t *testing.T) {
+	rgas := NewTypeTag(&StructTag{Address: AddressThree, Module: "gas_coin", Name: "RGas", TypeParams: []TypeTag{}})
+
+	tests := []struct {
+		input    string
+		expected TypeTag
+	}{
+		{"u8", NewTypeTag(&U8Tag{})},
+		{"signer", NewTypeTag(&SignerTag{})},
+		{" vector< vector<u256> > ", NewTypeTag(NewVectorTag(NewVectorTag(&U256Tag{})))},
+		{"0x3::gas_coin::RGas", rgas},
+		{"rooch_framework::gas_coin::RGas", rgas},
+		{"0x0000000000000000000000000000000000000000000000000000000000000003::gas_coin::RGas", rgas},
+		{"0x1::string::String", NewTypeTag(&StructTag{Address: AddressOne, Module: "string", Name: "String", TypeParams: []TypeTag{}})},
+		{"0x3::coin_store::CoinStore< 0x3::gas_coin::RGas >", NewTypeTag(&StructTag{Address: AddressThree, Module: "coin_store", Name: "CoinStore", TypeParams: []TypeTag{rgas}})},
+		{
+			"0x42::m::A<0x42::m::B<0x42::m::C>,vector<u8>,>",
+			NewTypeTag(&StructTag{Address: mustParseAddress("0x42"), Module: "m", Name: "A", TypeParams: []TypeTag{
+				NewTypeTag(&StructTag{Address: mustParseAddress("0x42"), Module: "m", Name: "B", TypeParams: []TypeTag{
+					NewTypeTag(&StructTag{Address: mustParseAddress("0x42"), Module: "m", Name: "C", TypeParams: []TypeTag{}}),
+				}}),
+				NewTypeTag(NewVectorTag(&U8Tag{})),
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			parsed, err := ParseTypeTag(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, &tt.expected, parsed)
+
+			// parse -> canonical -> parse and parse -> short -> parse are identities
+			canonical, err := ParseTypeTag(TypeTagToCanonicalString(parsed))
+			assert.NoError(t, err)
+			assert.Equal(t, parsed, canonical)
+			short, err := ParseTypeTag(TypeTagToShortString(parsed))
+			assert.NoError(t, err)
+			assert.Equal(t, parsed, short)
+			str, err := ParseTypeTag(parsed.String())
+			assert.NoError(t, err)
+			assert.Equal(t, parsed, str)
+		})
+	}
+}
+
+func TestTypeTagFormatting(t *testing.T) {
+	parsed, err := ParseTypeTag("0x3::coin_store::CoinStore<0x3::gas_coin::RGas>")
+	assert.NoError(t, err)
+	assert.Equal(t, "0x3::coin_store::CoinStore<0x3::gas_coin::RGas>", TypeTagToShortString(parsed))
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000003::coin_store::CoinStore<0x0000000000000000000000000000000000000000000000000000000000000003::gas_coin::RGas>", TypeTagToCanonicalString(parsed))
+}
+
+func TestParseAbiTypeTag(t *testing.T) {
+	parsed, err := ParseAbiTypeTag("&mut 0x2::object::Object<T0>")
+	assert.NoError(t, err)
+	assert.Equal(t, &TypeTag{&ReferenceTag{Mutable: true, TypeParam: NewTypeTag(&StructTag{
+		Address: AddressTwo, Module: "object", Name: "Object", TypeParams: []TypeTag{NewTypeTag(&GenericTag{Index: 0})},
+	})}}, parsed)
+	assert.Equal(t, "&mut 0x2::object::Object<T0>", parsed.String())
+
+	parsed, err = ParseAbiTypeTag("&signer")
+	assert.NoError(t, err)
+	assert.Equal(t, "&signer", TypeTagToCanonicalString(parsed))
+
+	parsed, err = ParseAbiTypeTag("vector<T12>")
+	assert.NoError(t, err)
+	assert.Equal(t, "vector<T12>", parsed.String())
+
+	// References and generics are not concrete types and can't be encoded
+	_, err = ParseTypeTag("&signer")
+	assert.ErrorIs(t, err, ErrInvalidTypeTag)
+	_, err = ParseTypeTag("T0")
+	assert.ErrorIs(t, err, ErrInvalidTypeTag)
+	_, err = bcs.Serialize(parsed)
+	assert.Error(t, err)
+}
+
+func TestParseTypeTagErrors(t *testing.T) {
+	tests := []struct {
+		input   string
+		message string
+	}{
+		{"", "expected a type but found end of input at position 0"},
+		{"u64 u8", `expected end of input but found "u8" at position 4`},
+		{"vector<u8", "expected '>' but found end of input at position 9"},
+		{"0x1::string::String>", "expected end of input but found '>' at position 19"},
+		{"0x1::string", "expected '::' but found end of input at position 11"},
+		{"0x1:string::String", "expected '::' at position 3"},
+		{"0xzz::m::S", `invalid address "0xzz" at position 0`},
+		{"foo::m::S", `invalid address "foo" at position 0`},
+		{"uint64", `unknown type "uint64" at position 0`},
+		{"0x1::m::S<u8 u16>", `expected ',' or '>' but found "u16" at position 13`},
+		{"&&u8", "unexpected reference at position 0"},
+		{"vector<u8>#", "unexpected character '#' at position 10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseTypeTag(tt.input)
+			assert.ErrorIs(t, err, ErrInvalidTypeTag)
+			assert.ErrorContains(t, err, tt.message)
+		})
+	}
+}
+
+func TestParseTypeTags(t *testing.T) {
+	tags, err := ParseTypeTags("u8, 0x1::option::Option<u64>, vector<address>")
+	assert.NoError(t, err)
+	assert.Len(t, tags, 3)
+	assert.Equal(t, "0x1::option::Option<u64>", tags[1].String())
+
+	tags, err = ParseTypeTags("  ")
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+
+	st, err := ParseStructTag("std::string::String")
+	assert.NoError(t, err)
+	assert.Equal(t, NewStringTag(), st)
+	_, err = ParseStructTag("u8")
+	assert.ErrorIs(t, err, ErrInvalidTypeTag)
+}
+
+func mustParseAddress(input string) RoochAddress {
+	var addr RoochAddress
+	if err := addr.ParseStringRelaxed(input); err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -10,7 +10,7 @@ func TestTypeTag(t *testing.T) {
 	// This is unfortunate with references
 	nested := NewTypeTag(NewOptionTag(NewVectorTag(NewObjectTag(NewStringTag()))))
 
-	assert.Equal(t, "0x1::option::Option<vector<0x1::object::Object<0x1::string::String>>>", nested.String())
+	assert.Equal(t, "0x1::option::Option<vector<0x2::object::Object<0x1::string::String>>>", nested.String())
 
 	bytes, err := bcs.Serialize(&nested)
 	assert.NoError(t, err)
@@ -72,12 +72,12 @@ func TestStructTag(t *testing.T) {
 	err := aa3.ParseStringRelaxed("0x3")
 	assert.NoError(t, err)
 	st.TypeParams = append(st.TypeParams, TypeTag{Value: &StructTag{
-		Address:    aa3,
+		Address:    AddressThree,
 		Module:     "other",
 		Name:       "thing",
 		TypeParams: nil,
 	}})
-	assert.Equal(t, "0x1::coin::CoinStore<0x1::gas_coin::RGas,0x3::other::thing>", st.String())
+	assert.Equal(t, "0x1::coin::CoinStore<0x1::gas_coin::RGas, 0x3::other::thing>", st.String())
 }
 
 func TestInvalidTypeTag(t *testing.T) {
@@ -0,0 +1,442 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+)
+
+//pub enum AbortLocation {
+//    Module(ModuleId),
+//    Script,
+//}
+
+// AbortLocation is the module where a Move abort or execution failure happened, Module is nil for scripts
+type AbortLocation struct {
+	Module *ModuleId
+}
+
+// IsScript returns true when the location is a script
+func (al *AbortLocation) IsScript() bool {
+	return al.Module == nil
+}
+
+// String outputs the module as address::module, or script
+func (al *AbortLocation) String() string {
+	if al.IsScript() {
+		return "script"
+	}
+	return al.Module.String()
+}
+
+// ParseAbortLocation parses the location string of the RPC views, address::module or script
+func ParseAbortLocation(input string) (AbortLocation, error) {
+	if strings.EqualFold(input, "script") {
+		return AbortLocation{}, nil
+	}
+	module, err := ParseModuleId(input)
+	if err != nil {
+		return AbortLocation{}, fmt.Errorf("invalid abort location: %w", err)
+	}
+	return AbortLocation{Module: module}, nil
+}
+
+func (al *AbortLocation) MarshalBCS(ser *bcs.Serializer) {
+	if al.IsScript() {
+		ser.Uleb128(1)
+		return
+	}
+	ser.Uleb128(0)
+	al.Module.MarshalBCS(ser)
+}
+
+func (al *AbortLocation) UnmarshalBCS(des *bcs.Deserializer) {
+	switch variant := des.Uleb128(); variant {
+	case 0:
+		al.Module = &ModuleId{}
+		al.Module.UnmarshalBCS(des)
+	case 1:
+		al.Module = nil
+	default:
+		des.SetError(fmt.Errorf("invalid abort location variant %d", variant))
+	}
+}
+
+//pub enum KeptVMStatus {
+//    Executed,
+//    OutOfGas,
+//    MoveAbort(AbortLocation, u64),
+//    ExecutionFailure {
+//        location: AbortLocation,
+//        function: u16,
+//        code_offset: u16,
+//    },
+//    MiscellaneousError,
+//}
+
+// KeptVMStatusVariant is the variant of a [KeptVMStatus], the values are the BCS enum indexes
+type KeptVMStatusVariant uint32
+
+const (
+	KeptVMStatusExecuted           KeptVMStatusVariant = 0
+	KeptVMStatusOutOfGas           KeptVMStatusVariant = 1
+	KeptVMStatusMoveAbort          KeptVMStatusVariant = 2
+	KeptVMStatusExecutionFailure   KeptVMStatusVariant = 3
+	KeptVMStatusMiscellaneousError KeptVMStatusVariant = 4
+)
+
+var keptVMStatusNames = map[KeptVMStatusVariant]string{
+	KeptVMStatusExecuted:           "executed",
+	KeptVMStatusOutOfGas:           "outofgas",
+	KeptVMStatusMoveAbort:          "moveabort",
+	KeptVMStatusExecutionFailure:   "executionfailure",
+	KeptVMStatusMiscellaneousError: "miscellaneouserror",
+}
+
+// String returns the type name used in the RPC views e.g. moveabort
+func (v KeptVMStatusVariant) String() string {
+	if name, ok := keptVMStatusNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", uint32(v))
+}
+
+// KeptVMStatus is the status of an executed transaction. Location and AbortCode are set for
+// [KeptVMStatusMoveAbort], Location, Function and CodeOffset for [KeptVMStatusExecutionFailure]
+type KeptVMStatus struct {
+	Type       KeptVMStatusVariant
+	Location   AbortLocation
+	AbortCode  uint64
+	Function   uint16
+	CodeOffset uint16
+}
+
+// IsExecuted returns true if the transaction was executed successfully
+func (s *KeptVMStatus) IsExecuted() bool {
+	return s.Type == KeptVMStatusExecuted
+}
+
+// AbortSubStatus splits the abort code of a [KeptVMStatusMoveAbort] into its error category and
+// reason, it is nil for other statuses
+func (s *KeptVMStatus) AbortSubStatus() *utils.SubStatus {
+	if s.Type != KeptVMStatusMoveAbort {
+		return nil
+	}
+	subStatus := utils.ParseAbortCode(s.AbortCode)
+	return &subStatus
+}
+
+// ErrorName returns the name of the abort code of a [KeptVMStatusMoveAbort] in registry
+func (s *KeptVMStatus) ErrorName(registry *AbortCodeRegistry) (string, bool) {
+	if s.Type != KeptVMStatusMoveAbort {
+		return "", false
+	}
+	return registry.Lookup(s.Location, s.AbortCode)
+}
+
+// Describe returns a human-readable description of the status, abort codes are named with registry
+// which may be nil
+func (s *KeptVMStatus) Describe(registry *AbortCodeRegistry) string {
+	switch s.Type {
+	case KeptVMStatusExecuted:
+		return "executed"
+	case KeptVMStatusOutOfGas:
+		return "out of gas"
+	case KeptVMStatusMoveAbort:
+		description := fmt.Sprintf("move abort in %s with code %d", s.Location.String(), s.AbortCode)
+		if subStatus := s.AbortSubStatus(); subStatus.Category != 0 {
+			description += fmt.Sprintf(" (%s, reason %d)", subStatus.Category.String(), subStatus.Reason)
+		}
+		if name, ok := s.ErrorName(registry); ok {
+			description += ": " + name
+		}
+		return description
+	case KeptVMStatusExecutionFailure:
+		return fmt.Sprintf("execution failure in %s, function %d at code offset %d", s.Location.String(), s.Function, s.CodeOffset)
+	case KeptVMStatusMiscellaneousError:
+		return "miscellaneous error"
+	default:
+		return s.Type.String()
+	}
+}
+
+// String returns the description of the status without abort code names
+func (s KeptVMStatus) String() string {
+	return s.Describe(nil)
+}
+
+func (s *KeptVMStatus) MarshalBCS(ser *bcs.Serializer) {
+	ser.Uleb128(uint32(s.Type))
+	switch s.Type {
+	case KeptVMStatusMoveAbort:
+		s.Location.MarshalBCS(ser)
+		ser.U64(s.AbortCode)
+	case KeptVMStatusExecutionFailure:
+		s.Location.MarshalBCS(ser)
+		ser.U16(s.Function)
+		ser.U16(s.CodeOffset)
+	case KeptVMStatusExecuted, KeptVMStatusOutOfGas, KeptVMStatusMiscellaneousError:
+	default:
+		ser.SetError(fmt.Errorf("invalid kept vm status variant %d", s.Type))
+	}
+}
+
+func (s *KeptVMStatus) UnmarshalBCS(des *bcs.Deserializer) {
+	*s = KeptVMStatus{Type: KeptVMStatusVariant(des.Uleb128())}
+	switch s.Type {
+	case KeptVMStatusMoveAbort:
+		s.Location.UnmarshalBCS(des)
+		s.AbortCode = des.U64()
+	case KeptVMStatusExecutionFailure:
+		s.Location.UnmarshalBCS(des)
+		s.Function = des.U16()
+		s.CodeOffset = des.U16()
+	case KeptVMStatusExecuted, KeptVMStatusOutOfGas, KeptVMStatusMiscellaneousError:
+	default:
+		des.SetError(fmt.Errorf("invalid kept vm status variant %d", s.Type))
+	}
+}
+
+type keptVMStatusView struct {
+	Type       string  `json:"type"`
+	Location   *string `json:"location,omitempty"`
+	AbortCode  *string `json:"abort_code,omitempty"`
+	Function   *uint16 `json:"function,omitempty"`
+	CodeOffset *uint16 `json:"code_offset,omitempty"`
+}
+
+// MarshalJSON converts the KeptVMStatus to the KeptVMStatusView of the RPC
+func (s KeptVMStatus) MarshalJSON() ([]byte, error) {
+	view := keptVMStatusView{Type: s.Type.String()}
+	switch s.Type {
+	case KeptVMStatusMoveAbort:
+		location := s.Location.String()
+		abortCode := strconv.FormatUint(s.AbortCode, 10)
+		view.Location, view.AbortCode = &location, &abortCode
+	case KeptVMStatusExecutionFailure:
+		location := s.Location.String()
+		view.Location, view.Function, view.CodeOffset = &location, &s.Function, &s.CodeOffset
+	}
+	return json.Marshal(view)
+}
+
+// UnmarshalJSON converts the KeptVMStatus from the KeptVMStatusView of the RPC
+func (s *KeptVMStatus) UnmarshalJSON(b []byte) error {
+	var view keptVMStatusView
+	if err := json.Unmarshal(b, &view); err != nil {
+		return fmt.Errorf("failed to convert input to KeptVMStatus: %w", err)
+	}
+	status := KeptVMStatus{}
+	found := false
+	for variant, name := range keptVMStatusNames {
+		if strings.EqualFold(view.Type, name) {
+			status.Type, found = variant, true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("unknown kept vm status type %s", view.Type)
+	}
+	if status.Type == KeptVMStatusMoveAbort || status.Type == KeptVMStatusExecutionFailure {
+		if view.Location == nil {
+			return fmt.Errorf("%s status has no location", view.Type)
+		}
+		location, err := ParseAbortLocation(*view.Location)
+		if err != nil {
+			return err
+		}
+		status.Location = location
+	}
+	if status.Type == KeptVMStatusMoveAbort && view.AbortCode != nil {
+		abortCode, err := strconv.ParseUint(*view.AbortCode, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid abort code %s: %w", *view.AbortCode, err)
+		}
+		status.AbortCode = abortCode
+	}
+	if status.Type == KeptVMStatusExecutionFailure {
+		if view.Function != nil {
+			status.Function = *view.Function
+		}
+		if view.CodeOffset != nil {
+			status.CodeOffset = *view.CodeOffset
+		}
+	}
+	*s = status
+	return nil
+}
+
+// VMStatus is the status of a function call or dry run, returned as the VMStatusView of the RPC.
+// It carries the [KeptVMStatus] along with the status code the VM reported for errors
+type VMStatus struct {
+	Status KeptVMStatus
+	// StatusCode is the name of the status code of an Error, e.g. OUT_OF_GAS, or the status code
+	// of an ExecutionFailure
+	StatusCode string
+	// SubStatus is the optional sub status of an ExecutionFailure
+	SubStatus *uint64
+}
+
+// IsExecuted returns true if the call was executed successfully
+func (s *VMStatus) IsExecuted() bool {
+	return s.Status.IsExecuted()
+}
+
+// String returns the description of the status
+func (s VMStatus) String() string {
+	if s.Status.Type == KeptVMStatusMiscellaneousError && s.StatusCode != "" {
+		return "error " + s.StatusCode
+	}
+	return s.Status.String()
+}
+
+// MarshalJSON converts the VMStatus to the VMStatusView of the RPC
+func (s VMStatus) MarshalJSON() ([]byte, error) {
+	switch s.Status.Type {
+	case KeptVMStatusExecuted:
+		return json.Marshal("Executed")
+	case KeptVMStatusMoveAbort:
+		return json.Marshal(map[string]any{"MoveAbort": map[string]any{
+			"location":   s.Status.Location.String(),
+			"abort_code": strconv.FormatUint(s.Status.AbortCode, 10),
+		}})
+	case KeptVMStatusExecutionFailure:
+		failure := map[string]any{
+			"location":    s.Status.Location.String(),
+			"function":    s.Status.Function,
+			"code_offset": s.Status.CodeOffset,
+			"status_code": s.StatusCode,
+		}
+		if s.SubStatus != nil {
+			failure["sub_status"] = strconv.FormatUint(*s.SubStatus, 10)
+		}
+		return json.Marshal(map[string]any{"ExecutionFailure": failure})
+	case KeptVMStatusOutOfGas:
+		return json.Marshal(map[string]string{"Error": "OUT_OF_GAS"})
+	default:
+		return json.Marshal(map[string]string{"Error": s.StatusCode})
+	}
+}
+
+// UnmarshalJSON converts the VMStatus from the VMStatusView of the RPC, either "Executed" or an
+// object keyed by the Error, MoveAbort or ExecutionFailure variant
+func (s *VMStatus) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		if !strings.EqualFold(name, "Executed") {
+			return fmt.Errorf("unknown vm status %s", name)
+		}
+		*s = VMStatus{Status: KeptVMStatus{Type: KeptVMStatusExecuted}}
+		return nil
+	}
+
+	type inner struct {
+		Error     *string `json:"Error"`
+		MoveAbort *struct {
+			Location  string `json:"location"`
+			AbortCode strU64 `json:"abort_code"`
+		} `json:"MoveAbort"`
+		ExecutionFailure *struct {
+			Location   string          `json:"location"`
+			Function   uint16          `json:"function"`
+			CodeOffset uint16          `json:"code_offset"`
+			StatusCode json.RawMessage `json:"status_code"`
+			SubStatus  *strU64         `json:"sub_status"`
+		} `json:"ExecutionFailure"`
+	}
+	data := &inner{}
+	if err := json.Unmarshal(b, data); err != nil {
+		return fmt.Errorf("failed to convert input to VMStatus: %w", err)
+	}
+
+	status := VMStatus{}
+	switch {
+	case data.Error != nil:
+		status.StatusCode = *data.Error
+		status.Status.Type = KeptVMStatusMiscellaneousError
+		if status.StatusCode == "OUT_OF_GAS" {
+			status.Status.Type = KeptVMStatusOutOfGas
+		}
+	case data.MoveAbort != nil:
+		location, err := ParseAbortLocation(data.MoveAbort.Location)
+		if err != nil {
+			return err
+		}
+		status.Status = KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: location, AbortCode: uint64(data.MoveAbort.AbortCode)}
+	case data.ExecutionFailure != nil:
+		failure := data.ExecutionFailure
+		location, err := ParseAbortLocation(failure.Location)
+		if err != nil {
+			return err
+		}
+		status.Status = KeptVMStatus{
+			Type:       KeptVMStatusExecutionFailure,
+			Location:   location,
+			Function:   failure.Function,
+			CodeOffset: failure.CodeOffset,
+		}
+		// The status code is the name or the number of the code depending on the node version
+		var statusCode string
+		if err := json.Unmarshal(failure.StatusCode, &statusCode); err != nil {
+			statusCode = string(failure.StatusCode)
+		}
+		status.StatusCode = statusCode
+		if failure.SubStatus != nil {
+			subStatus := uint64(*failure.SubStatus)
+			status.SubStatus = &subStatus
+		}
+	default:
+		return fmt.Errorf("unknown vm status %s", string(b))
+	}
+	*s = status
+	return nil
+}
+
+// AbortCodeRegistry maps the abort codes of modules to human-readable error names, e.g. the
+// ErrorInsufficientBalance constant of 0x3::coin_store. It is safe for concurrent use
+type AbortCodeRegistry struct {
+	mu    sync.RWMutex
+	names map[string]string
+}
+
+// NewAbortCodeRegistry creates an empty AbortCodeRegistry
+func NewAbortCodeRegistry() *AbortCodeRegistry {
+	return &AbortCodeRegistry{names: make(map[string]string)}
+}
+
+func abortCodeKey(module string, code uint64) string {
+	return module + "#" + strconv.FormatUint(code, 10)
+}
+
+// Register names the abort code of the module, the code is either the error constant or the full
+// canonical code
+func (r *AbortCodeRegistry) Register(module *ModuleId, code uint64, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names[abortCodeKey(module.String(), code)] = name
+}
+
+// Lookup returns the name of the abort code at location, canonical codes that are not registered
+// are looked up by their reason
+func (r *AbortCodeRegistry) Lookup(location AbortLocation, code uint64) (string, bool) {
+	if r == nil || location.IsScript() {
+		return "", false
+	}
+	module := location.Module.String()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if name, ok := r.names[abortCodeKey(module, code)]; ok {
+		return name, true
+	}
+	subStatus := utils.ParseAbortCode(code)
+	if subStatus.Category == 0 {
+		return "", false
+	}
+	name, ok := r.names[abortCodeKey(module, uint64(subStatus.Reason))]
+	return name, ok
+}
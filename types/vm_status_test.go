@@ -0,0 +1,171 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rooch-network/rooch-go-sdk/bcs"
+	"github.com/rooch-network/rooch-go-sdk/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeptVMStatus(t *testing.T) {
+	coin := &ModuleId{Address: AddressThree, Name: "coin"}
+	tests := []struct {
+		name   string
+		status KeptVMStatus
+		bcs    string
+		json   string
+	}{
+		{"executed", KeptVMStatus{Type: KeptVMStatusExecuted}, "00", `{"type":"executed"}`},
+		{"out of gas", KeptVMStatus{Type: KeptVMStatusOutOfGas}, "01", `{"type":"outofgas"}`},
+		{
+			"move abort",
+			KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: AbortLocation{Module: coin}, AbortCode: 65537},
+			"02" + "00" + strings.Repeat("00", 31) + "03" + "04" + hex.EncodeToString([]byte("coin")) + "0100010000000000",
+			`{"type":"moveabort","location":"0x3::coin","abort_code":"65537"}`,
+		},
+		{
+			"script abort",
+			KeptVMStatus{Type: KeptVMStatusMoveAbort, AbortCode: 1},
+			"02" + "01" + "0100000000000000",
+			`{"type":"moveabort","location":"script","abort_code":"1"}`,
+		},
+		{
+			"execution failure",
+			KeptVMStatus{Type: KeptVMStatusExecutionFailure, Location: AbortLocation{Module: coin}, Function: 2, CodeOffset: 9},
+			"03" + "00" + strings.Repeat("00", 31) + "03" + "04" + hex.EncodeToString([]byte("coin")) + "0200" + "0900",
+			`{"type":"executionfailure","location":"0x3::coin","function":2,"code_offset":9}`,
+		},
+		{"miscellaneous error", KeptVMStatus{Type: KeptVMStatusMiscellaneousError}, "04", `{"type":"miscellaneouserror"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := bcs.Serialize(&tt.status)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.bcs, hex.EncodeToString(encoded))
+
+			decoded := KeptVMStatus{}
+			assert.NoError(t, bcs.Deserialize(&decoded, encoded))
+			assert.Equal(t, tt.status, decoded)
+
+			jsonBytes, err := json.Marshal(tt.status)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(jsonBytes))
+
+			fromJson := KeptVMStatus{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.json), &fromJson))
+			assert.Equal(t, tt.status, fromJson)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		status := KeptVMStatus{}
+		assert.Error(t, json.Unmarshal([]byte(`{"type":"aborted"}`), &status))
+		assert.Error(t, json.Unmarshal([]byte(`{"type":"moveabort","abort_code":"1"}`), &status))
+		assert.Error(t, bcs.Deserialize(&status, []byte{0x05}))
+	})
+}
+
+func TestKeptVMStatusAbortCode(t *testing.T) {
+	coinStore := &ModuleId{Address: AddressThree, Name: "coin_store"}
+	registry := NewAbortCodeRegistry()
+	registry.Register(coinStore, 1, "ErrorInsufficientBalance")
+	registry.Register(coinStore, 0x60002, "ErrorCoinStoreNotFound")
+
+	t.Run("canonical code", func(t *testing.T) {
+		status := KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: AbortLocation{Module: coinStore}, AbortCode: 0x10001}
+		assert.Equal(t, &utils.SubStatus{Category: utils.ErrorCategoryInvalidArgument, Reason: 1, Code: 0x10001}, status.AbortSubStatus())
+		name, ok := status.ErrorName(registry)
+		assert.True(t, ok)
+		assert.Equal(t, "ErrorInsufficientBalance", name)
+		assert.Equal(t, "move abort in 0x3::coin_store with code 65537 (INVALID_ARGUMENT, reason 1): ErrorInsufficientBalance", status.Describe(registry))
+		assert.Equal(t, "move abort in 0x3::coin_store with code 65537 (INVALID_ARGUMENT, reason 1)", status.String())
+	})
+
+	t.Run("registered full code", func(t *testing.T) {
+		status := KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: AbortLocation{Module: coinStore}, AbortCode: 0x60002}
+		name, ok := status.ErrorName(registry)
+		assert.True(t, ok)
+		assert.Equal(t, "ErrorCoinStoreNotFound", name)
+	})
+
+	t.Run("raw code", func(t *testing.T) {
+		status := KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: AbortLocation{Module: coinStore}, AbortCode: 1}
+		assert.Equal(t, &utils.SubStatus{Reason: 1, Code: 1}, status.AbortSubStatus())
+		assert.Equal(t, "move abort in 0x3::coin_store with code 1: ErrorInsufficientBalance", status.Describe(registry))
+	})
+
+	t.Run("custom code above 24 bits", func(t *testing.T) {
+		// The low bits look like INVALID_ARGUMENT reason 1 but the code is not canonical
+		status := KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: AbortLocation{Module: coinStore}, AbortCode: 0x1010001}
+		assert.Equal(t, &utils.SubStatus{Code: 0x1010001}, status.AbortSubStatus())
+		_, ok := status.ErrorName(registry)
+		assert.False(t, ok)
+		assert.Equal(t, "move abort in 0x3::coin_store with code 16842753", status.Describe(registry))
+	})
+
+	t.Run("unknown module", func(t *testing.T) {
+		status := KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: AbortLocation{Module: &ModuleId{Address: AddressTwo, Name: "object"}}, AbortCode: 1}
+		_, ok := status.ErrorName(registry)
+		assert.False(t, ok)
+		_, ok = status.ErrorName(nil)
+		assert.False(t, ok)
+	})
+
+	t.Run("not an abort", func(t *testing.T) {
+		status := KeptVMStatus{Type: KeptVMStatusExecutionFailure, Location: AbortLocation{Module: coinStore}, Function: 1, CodeOffset: 4}
+		assert.Nil(t, status.AbortSubStatus())
+		assert.Equal(t, "execution failure in 0x3::coin_store, function 1 at code offset 4", status.Describe(registry))
+	})
+}
+
+func TestVMStatus(t *testing.T) {
+	subStatus := uint64(7)
+	tests := []struct {
+		name   string
+		json   string
+		status VMStatus
+	}{
+		{"executed", `"Executed"`, VMStatus{Status: KeptVMStatus{Type: KeptVMStatusExecuted}}},
+		{"out of gas", `{"Error":"OUT_OF_GAS"}`, VMStatus{Status: KeptVMStatus{Type: KeptVMStatusOutOfGas}, StatusCode: "OUT_OF_GAS"}},
+		{"error", `{"Error":"TYPE_MISMATCH"}`, VMStatus{Status: KeptVMStatus{Type: KeptVMStatusMiscellaneousError}, StatusCode: "TYPE_MISMATCH"}},
+		{
+			"move abort",
+			`{"MoveAbort":{"location":"0x3::coin","abort_code":"65537"}}`,
+			VMStatus{Status: KeptVMStatus{Type: KeptVMStatusMoveAbort, Location: AbortLocation{Module: &ModuleId{Address: AddressThree, Name: "coin"}}, AbortCode: 65537}},
+		},
+		{
+			"execution failure",
+			`{"ExecutionFailure":{"location":"script","function":0,"code_offset":3,"status_code":"ARITHMETIC_ERROR","sub_status":"7"}}`,
+			VMStatus{Status: KeptVMStatus{Type: KeptVMStatusExecutionFailure, CodeOffset: 3}, StatusCode: "ARITHMETIC_ERROR", SubStatus: &subStatus},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := VMStatus{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.json), &status))
+			assert.Equal(t, tt.status, status)
+
+			jsonBytes, err := json.Marshal(status)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(jsonBytes))
+		})
+	}
+
+	t.Run("numeric status code", func(t *testing.T) {
+		status := VMStatus{}
+		assert.NoError(t, json.Unmarshal([]byte(`{"ExecutionFailure":{"location":"0x2::object","function":1,"code_offset":0,"status_code":"4017"}}`), &status))
+		assert.Equal(t, "4017", status.StatusCode)
+		assert.NoError(t, json.Unmarshal([]byte(`{"ExecutionFailure":{"location":"0x2::object","function":1,"code_offset":0,"status_code":4017}}`), &status))
+		assert.Equal(t, "4017", status.StatusCode)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		status := VMStatus{}
+		assert.Error(t, json.Unmarshal([]byte(`"Pending"`), &status))
+		assert.Error(t, json.Unmarshal([]byte(`{"Unknown":{}}`), &status))
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright (c) RoochNetwork
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"strings"
+)
+
+// base58xmr is the Monero flavour of base58, which encodes 8 byte blocks independently into 11
+// characters so the output length depends only on the input length
+
+const (
+	base58Alphabet      = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base58XMRBlockSize  = 8
+	base58XMREncodedLen = 11
+)
+
+// base58XMREncodedSizes maps the byte length of a block to the length of its encoding
+var base58XMREncodedSizes = [base58XMRBlockSize + 1]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+var errInvalidBase58XMR = errors.New("invalid base58xmr string")
+
+// Base58XMREncode encodes bytes with the Monero base58 block encoding
+func Base58XMREncode(input []byte) string {
+	var sb strings.Builder
+	for i := 0; i < len(input); i += base58XMRBlockSize {
+		end := i + base58XMRBlockSize
+		if end > len(input) {
+			end = len(input)
+		}
+		block := input[i:end]
+
+		var buf [base58XMRBlockSize]byte
+		copy(buf[base58XMRBlockSize-len(block):], block)
+		num := binary.BigEndian.Uint64(buf[:])
+
+		encoded := make([]byte, base58XMREncodedSizes[len(block)])
+		for j := len(encoded) - 1; j >= 0; j-- {
+			encoded[j] = base58Alphabet[num%58]
+			num /= 58
+		}
+		sb.Write(encoded)
+	}
+	return sb.String()
+}
+
+// Base58XMRDecode decodes a Monero base58 string
+func Base58XMRDecode(input string) ([]byte, error) {
+	result := make([]byte, 0, len(input)*base58XMRBlockSize/base58XMREncodedLen+base58XMRBlockSize)
+	for i := 0; i < len(input); i += base58XMREncodedLen {
+		end := i + base58XMREncodedLen
+		if end > len(input) {
+			end = len(input)
+		}
+		block := input[i:end]
+
+		size := -1
+		for n, encodedSize := range base58XMREncodedSizes {
+			if encodedSize == len(block) {
+				size = n
+				break
+			}
+		}
+		if size <= 0 {
+			return nil, errInvalidBase58XMR
+		}
+
+		var num uint64
+		for j := 0; j < len(block); j++ {
+			digit := strings.IndexByte(base58Alphabet, block[j])
+			if digit < 0 {
+				return nil, errInvalidBase58XMR
+			}
+			hi, lo := bits.Mul64(num, 58)
+			sum, carry := bits.Add64(lo, uint64(digit), 0)
+			if hi != 0 || carry != 0 {
+				return nil, errInvalidBase58XMR
+			}
+			num = sum
+		}
+		if size < base58XMRBlockSize && num>>(8*size) != 0 {
+			return nil, errInvalidBase58XMR
+		}
+
+		var buf [base58XMRBlockSize]byte
+		binary.BigEndian.PutUint64(buf[:], num)
+		result = append(result, buf[base58XMRBlockSize-size:]...)
+	}
+	return result, nil
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"strings"
 )
 
 type CodecType string
@@ -64,8 +65,10 @@ func BytesToString(typ CodecType, bytes []byte) (string, error) {
 	switch typ {
 	case UTF8:
 		return string(bytes), nil
-	case HEX, BASE16:
+	case HEX:
 		return hex.EncodeToString(bytes), nil
+	case BASE16:
+		return strings.ToUpper(hex.EncodeToString(bytes)), nil
 	case BASE32:
 		return base32.StdEncoding.EncodeToString(bytes), nil
 	case BASE64:
@@ -75,8 +78,8 @@ func BytesToString(typ CodecType, bytes []byte) (string, error) {
 	case BASE58:
 		//return base58.base58Encode(bytes), nil
 		return base58.Encode(bytes), nil
-	//case BASE58XMR:
-	//	return base58.XMREncode(bytes), nil
+	case BASE58XMR:
+		return Base58XMREncode(bytes), nil
 	default:
 		return "", fmt.Errorf(coderTypeError)
 	}
@@ -106,8 +109,8 @@ func StringToBytes(typ CodecType, str string) ([]byte, error) {
 		return base64.URLEncoding.DecodeString(str)
 	case BASE58:
 		return base58.Decode(str), nil
-	//case BASE58XMR:
-	//	return base58XMRDecode(str)
+	case BASE58XMR:
+		return Base58XMRDecode(str)
 	default:
 		return nil, fmt.Errorf(coderTypeError)
 	}
@@ -44,7 +44,7 @@ func TestBytesToString(t *testing.T) {
 	input := []byte("Hello")
 	tests := []struct {
 		name     string
-		encoding CoderType
+		encoding CodecType
 		expected string
 	}{
 		{"utf8", UTF8, "Hello"},
@@ -75,7 +75,7 @@ func TestStringToBytes(t *testing.T) {
 	expected := []byte("Hello")
 	tests := []struct {
 		name     string
-		encoding CoderType
+		encoding CodecType
 		input    string
 	}{
 		{"utf8", UTF8, "Hello"},
@@ -128,10 +128,48 @@ func TestConcatBytes(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := ConcatBytes(tt.chunks...)
+			got, err := ConcatBytes(tt.chunks...)
+			if err != nil {
+				t.Fatalf("ConcatBytes() error = %v", err)
+			}
 			if !BytesEqual(got, tt.expected) {
 				t.Errorf("ConcatBytes() = %v, want %v", got, tt.expected)
 			}
 		})
 	}
-} 
\ No newline at end of file
+} 
+func TestBase58XMR(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		encoded string
+	}{
+		{"empty", []byte{}, ""},
+		{"zero byte", []byte{0x00}, "11"},
+		{"partial block", []byte{0xff}, "5Q"},
+		{"full block", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "jpXCZedGfVQ"},
+		{"full and partial block", []byte("Hello world"), "D7LMXYjUdxr1fS9Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Base58XMREncode(tt.input); got != tt.encoded {
+				t.Errorf("Base58XMREncode() = %v, want %v", got, tt.encoded)
+			}
+			got, err := Base58XMRDecode(tt.encoded)
+			if err != nil {
+				t.Errorf("Base58XMRDecode() error = %v", err)
+				return
+			}
+			if !BytesEqual(got, tt.input) {
+				t.Errorf("Base58XMRDecode() = %v, want %v", got, tt.input)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"1", "0O", "zzzzzzzzzzz", "zz"} {
+		if _, err := Base58XMRDecode(invalid); err == nil {
+			t.Errorf("Base58XMRDecode(%q) expected an error", invalid)
+		}
+	}
+}
@@ -3,29 +3,53 @@
 
 package utils
 
+import (
+	"regexp"
+	"strconv"
+)
+
 // ErrorCategory represents different types of errors with their corresponding codes
 type ErrorCategory uint16
 
 const (
-	InvalidArgument    ErrorCategory = 0x1 // Caller specified an invalid argument (http: 400)
-	OutOfRange        ErrorCategory = 0x2 // An input or result of a computation is out of range (http: 400)
-	InvalidState      ErrorCategory = 0x3 // The system is not in a state where the operation can be performed (http: 400)
-	Unauthenticated   ErrorCategory = 0x4 // Request not authenticated due to missing, invalid, or expired auth token (http: 401)
-	PermissionDenied  ErrorCategory = 0x5 // client does not have sufficient permission (http: 403)
-	NotFound          ErrorCategory = 0x6 // A specified resource is not found (http: 404)
-	Aborted           ErrorCategory = 0x7 // Concurrency conflict, such as read-modify-write conflict (http: 409)
-	AlreadyExists     ErrorCategory = 0x8 // The resource that a client tried to create already exists (http: 409)
-	ResourceExhausted ErrorCategory = 0x9 // Out of gas or other forms of quota (http: 429)
-	Cancelled         ErrorCategory = 0xa // Request cancelled by the client (http: 499)
-	Internal          ErrorCategory = 0xb // Internal error (http: 500)
-	NotImplemented    ErrorCategory = 0xc // Feature not implemented (http: 501)
-	Unavailable       ErrorCategory = 0xd // The service is currently unavailable. Indicates that a retry could solve the issue (http: 503)
+	ErrorCategoryInvalidArgument   ErrorCategory = 0x1 // Caller specified an invalid argument (http: 400)
+	ErrorCategoryOutOfRange        ErrorCategory = 0x2 // An input or result of a computation is out of range (http: 400)
+	ErrorCategoryInvalidState      ErrorCategory = 0x3 // The system is not in a state where the operation can be performed (http: 400)
+	ErrorCategoryUnauthenticated   ErrorCategory = 0x4 // Request not authenticated due to missing, invalid, or expired auth token (http: 401)
+	ErrorCategoryPermissionDenied  ErrorCategory = 0x5 // client does not have sufficient permission (http: 403)
+	ErrorCategoryNotFound          ErrorCategory = 0x6 // A specified resource is not found (http: 404)
+	ErrorCategoryAborted           ErrorCategory = 0x7 // Concurrency conflict, such as read-modify-write conflict (http: 409)
+	ErrorCategoryAlreadyExists     ErrorCategory = 0x8 // The resource that a client tried to create already exists (http: 409)
+	ErrorCategoryResourceExhausted ErrorCategory = 0x9 // Out of gas or other forms of quota (http: 429)
+	ErrorCategoryCancelled         ErrorCategory = 0xa // Request cancelled by the client (http: 499)
+	ErrorCategoryInternal          ErrorCategory = 0xb // Internal error (http: 500)
+	ErrorCategoryNotImplemented    ErrorCategory = 0xc // Feature not implemented (http: 501)
+	ErrorCategoryUnavailable       ErrorCategory = 0xd // The service is currently unavailable. Indicates that a retry could solve the issue (http: 503)
+)
+
+// Deprecated: the unprefixed category names are kept for compatibility, use the ErrorCategory
+// prefixed names instead
+const (
+	InvalidArgument   = ErrorCategoryInvalidArgument
+	OutOfRange        = ErrorCategoryOutOfRange
+	InvalidState      = ErrorCategoryInvalidState
+	Unauthenticated   = ErrorCategoryUnauthenticated
+	PermissionDenied  = ErrorCategoryPermissionDenied
+	NotFound          = ErrorCategoryNotFound
+	Aborted           = ErrorCategoryAborted
+	AlreadyExists     = ErrorCategoryAlreadyExists
+	ResourceExhausted = ErrorCategoryResourceExhausted
+	Cancelled         = ErrorCategoryCancelled
+	Internal          = ErrorCategoryInternal
+	NotImplemented    = ErrorCategoryNotImplemented
+	Unavailable       = ErrorCategoryUnavailable
 )
 
 // SubStatus represents the error category and reason
 type SubStatus struct {
 	Category ErrorCategory
-	Reason   uint16
+	Reason   uint16 // Reason is 0 for a code that is not canonical and does not fit, see Code
+	Code     uint64 // Code is the full abort code
 }
 
 // ParseRoochErrorCode parses the error code from a Rooch RPC error message
@@ -56,32 +80,50 @@ func ParseRoochErrorSubStatus(errorMessage string) *SubStatus {
 		return nil
 	}
 
-	return &SubStatus{
-		Category: ErrorCategory(*errorCode >> 16),
-		Reason:   uint16(*errorCode & 0xffff),
+	subStatus := ParseAbortCode(uint64(*errorCode))
+	return &subStatus
+}
+
+// ParseAbortCode splits a Move abort code into the error category and reason it was built from by
+// std::error::canonical. A code is canonical when it fits in 24 bits and its category is a known one,
+// other codes have no category and the code as reason when it fits in 16 bits
+func ParseAbortCode(code uint64) SubStatus {
+	category := ErrorCategory(code >> 16)
+	if code <= 0xffffff && category >= ErrorCategoryInvalidArgument && category <= ErrorCategoryUnavailable {
+		return SubStatus{Category: category, Reason: uint16(code & 0xffff), Code: code}
+	}
+	subStatus := SubStatus{Code: code}
+	if code <= 0xffff {
+		subStatus.Reason = uint16(code)
 	}
+	return subStatus
 }
 
 // GetErrorCategoryName returns the string representation of an ErrorCategory
 func GetErrorCategoryName(code ErrorCategory) string {
 	categoryNames := map[ErrorCategory]string{
-		InvalidArgument:    "INVALID_ARGUMENT",
-		OutOfRange:        "OUT_OF_RANGE",
-		InvalidState:      "INVALID_STATE",
-		Unauthenticated:   "UNAUTHENTICATED",
-		PermissionDenied:  "PERMISSION_DENIED",
-		NotFound:          "NOT_FOUND",
-		Aborted:           "ABORTED",
-		AlreadyExists:     "ALREADY_EXISTS",
-		ResourceExhausted: "RESOURCE_EXHAUSTED",
-		Cancelled:         "CANCELLED",
-		Internal:          "INTERNAL",
-		NotImplemented:    "NOT_IMPLEMENTED",
-		Unavailable:       "UNAVAILABLE",
+		ErrorCategoryInvalidArgument:   "INVALID_ARGUMENT",
+		ErrorCategoryOutOfRange:        "OUT_OF_RANGE",
+		ErrorCategoryInvalidState:      "INVALID_STATE",
+		ErrorCategoryUnauthenticated:   "UNAUTHENTICATED",
+		ErrorCategoryPermissionDenied:  "PERMISSION_DENIED",
+		ErrorCategoryNotFound:          "NOT_FOUND",
+		ErrorCategoryAborted:           "ABORTED",
+		ErrorCategoryAlreadyExists:     "ALREADY_EXISTS",
+		ErrorCategoryResourceExhausted: "RESOURCE_EXHAUSTED",
+		ErrorCategoryCancelled:         "CANCELLED",
+		ErrorCategoryInternal:          "INTERNAL",
+		ErrorCategoryNotImplemented:    "NOT_IMPLEMENTED",
+		ErrorCategoryUnavailable:       "UNAVAILABLE",
 	}
 
 	if name, ok := categoryNames[code]; ok {
 		return name
 	}
 	return "UNKNOWN"
-} 
\ No newline at end of file
+}
+
+// String returns the name of the ErrorCategory e.g. INVALID_ARGUMENT
+func (c ErrorCategory) String() string {
+	return GetErrorCategoryName(c)
+}
@@ -62,6 +62,45 @@ func TestParseRoochErrorSubStatus(t *testing.T) {
 	})
 }
 
+func TestParseAbortCode(t *testing.T) {
+	t.Run("should split a canonical abort code", func(t *testing.T) {
+		subStatus := ParseAbortCode(0x60002)
+		if subStatus.Category != ErrorCategoryNotFound || subStatus.Reason != 2 || subStatus.Code != 0x60002 {
+			t.Errorf("Expected NOT_FOUND reason 2, got %v", subStatus)
+		}
+	})
+
+	t.Run("should keep a raw abort code as reason", func(t *testing.T) {
+		subStatus := ParseAbortCode(12)
+		if subStatus.Category != 0 || subStatus.Reason != 12 || subStatus.Code != 12 {
+			t.Errorf("Expected reason 12 without category, got %v", subStatus)
+		}
+	})
+
+	t.Run("should not split a code that is not canonical", func(t *testing.T) {
+		testCases := []uint64{
+			0x10000000,         // above 24 bits, the category would be 0x1000
+			0x1000001,          // above 24 bits, the category would be 0x100
+			0xe0001,            // unknown category 0xe
+			0xff0001,           // unknown category 0xff
+			0xdeadbeefcafebabe, // a large custom code
+		}
+		for _, code := range testCases {
+			subStatus := ParseAbortCode(code)
+			if subStatus.Category != 0 || subStatus.Reason != 0 || subStatus.Code != code {
+				t.Errorf("Expected code %#x without category, got %v", code, subStatus)
+			}
+		}
+	})
+
+	t.Run("should split every known category", func(t *testing.T) {
+		subStatus := ParseAbortCode(0xd0007)
+		if subStatus.Category != ErrorCategoryUnavailable || subStatus.Reason != 7 || subStatus.Code != 0xd0007 {
+			t.Errorf("Expected UNAVAILABLE reason 7, got %v", subStatus)
+		}
+	})
+}
+
 func TestGetErrorCategoryName(t *testing.T) {
 	t.Run("should return the correct string representation of the enum", func(t *testing.T) {
 		testCases := []struct {
@@ -42,19 +42,46 @@ func NormalizeHex(input string) string {
 	return strings.TrimPrefix(input, "0x")
 }
 
-// FromHEX converts a hex string to a byte array.
+// FromHEX converts a hex string to a byte array. Like the TypeScript SDK, each pair of characters
+// is parsed on its own, a pair that does not start with a hex digit decodes to 0 and a pair with an
+// invalid second character decodes from its first digit only.
 func FromHEX(input string) []uint8 {
+	input = NormalizeHex(input)
 	if len(input)%2 != 0 {
 		input = "0" + input
 	}
-	decoded, err := hex.DecodeString(input)
-	if err != nil {
-		// Return array of zeros for invalid input
-		return make([]uint8, len(input)/2)
+	decoded := make([]uint8, len(input)/2)
+	for i := range decoded {
+		decoded[i] = parseHexPair(input[2*i], input[2*i+1])
 	}
 	return decoded
 }
 
+// parseHexPair parses the leading hex digits of a pair of characters, as parseInt(pair, 16) does
+func parseHexPair(hi, lo byte) uint8 {
+	h, ok := hexDigit(hi)
+	if !ok {
+		return 0
+	}
+	l, ok := hexDigit(lo)
+	if !ok {
+		return h
+	}
+	return h<<4 | l
+}
+
+func hexDigit(c byte) (uint8, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
 // ToHEX converts a byte array to a hex string.
 func ToHEX(input []uint8) (string, error) {
 	return hex.EncodeToString(input), nil